@@ -1,11 +1,28 @@
 package array
 
 import (
+	"fmt"
+	"iter"
 	"unsafe"
 )
 
+// IndexError reports an access outside the bounds of an Array.
+type IndexError struct {
+	Index int
+	Len   int
+}
+
+func (e IndexError) Error() string {
+	return fmt.Sprintf("array: index %d out of range [0:%d]", e.Index, e.Len)
+}
+
+// Array is a fixed-size array whose elements are read and written through
+// raw pointer arithmetic on data.
 type Array[T any] struct {
-	data    unsafe.Pointer
+	data unsafe.Pointer
+	// backing is a byte view of the memory behind data. The memory itself is
+	// allocated as a []T, so the garbage collector sees any pointers stored
+	// in the elements and the elements are correctly aligned.
 	backing []byte
 	size    int
 }
@@ -20,29 +37,133 @@ func NewArray[T any](numElements int) *Array[T] {
 		return &Array[T]{}
 	}
 
-	backing := make([]byte, findOffset[T](numElements))
+	data := unsafe.Pointer(unsafe.SliceData(make([]T, numElements)))
 
 	return &Array[T]{
-		data:    unsafe.Pointer(&backing[0]),
+		data:    data,
 		size:    numElements,
-		backing: backing,
+		backing: unsafe.Slice((*byte)(data), findOffset[T](numElements)),
 	}
 }
 
-func (a *Array[T]) Get(i int) T {
-	if i < 0 || i >= a.size {
-		panic("segmentation fault")
+func (a *Array[T]) Len() int {
+	if a == nil {
+		return 0
 	}
+	return a.size
+}
+
+func (a *Array[T]) checkIndex(i int) error {
+	if i < 0 || i >= a.Len() {
+		return IndexError{Index: i, Len: a.Len()}
+	}
+	return nil
+}
+
+func (a *Array[T]) ptr(i int) *T {
+	return (*T)(unsafe.Add(a.data, findOffset[T](i)))
+}
 
-	offset := findOffset[T](i)
-	return *(*T)(unsafe.Pointer(uintptr(a.data) + offset))
+// Get returns the element at index i and panics with an IndexError if i is
+// out of bounds.
+func (a *Array[T]) Get(i int) T {
+	if err := a.checkIndex(i); err != nil {
+		panic(err)
+	}
+	return *a.ptr(i)
 }
 
+// Set stores val at index i and panics with an IndexError if i is out of
+// bounds.
 func (a *Array[T]) Set(val T, i int) {
-	if i < 0 || i >= a.size {
-		panic("segmentation fault")
+	if err := a.checkIndex(i); err != nil {
+		panic(err)
+	}
+	*a.ptr(i) = val
+}
+
+// At is like Get but returns an IndexError instead of panicking.
+func (a *Array[T]) At(i int) (T, error) {
+	if err := a.checkIndex(i); err != nil {
+		var noop T
+		return noop, err
 	}
+	return *a.ptr(i), nil
+}
 
-	offset := findOffset[T](i)
-	*(*T)(unsafe.Pointer(uintptr(a.data) + offset)) = val
+// SetAt is like Set but returns an IndexError instead of panicking.
+func (a *Array[T]) SetAt(i int, val T) error {
+	if err := a.checkIndex(i); err != nil {
+		return err
+	}
+	*a.ptr(i) = val
+	return nil
+}
+
+func (a *Array[T]) Swap(i, j int) {
+	if err := a.checkIndex(i); err != nil {
+		panic(err)
+	}
+	if err := a.checkIndex(j); err != nil {
+		panic(err)
+	}
+	pi, pj := a.ptr(i), a.ptr(j)
+	*pi, *pj = *pj, *pi
+}
+
+func (a *Array[T]) Fill(val T) {
+	for i := range a.Len() {
+		*a.ptr(i) = val
+	}
+}
+
+// CopyFrom copies src into the start of the array and returns the number of
+// elements copied, which is the minimum of len(src) and Len.
+func (a *Array[T]) CopyFrom(src []T) int {
+	n := min(len(src), a.Len())
+	for i := range n {
+		*a.ptr(i) = src[i]
+	}
+	return n
+}
+
+// Slice returns a view of the elements in [lo, hi). The view shares memory
+// with a, so writes through either are visible in both. It panics with an
+// IndexError if the bounds are invalid.
+func (a *Array[T]) Slice(lo, hi int) *Array[T] {
+	if hi < 0 || hi > a.Len() {
+		panic(IndexError{Index: hi, Len: a.Len()})
+	}
+	if lo < 0 || lo > hi {
+		panic(IndexError{Index: lo, Len: hi})
+	}
+	if lo == hi {
+		return &Array[T]{}
+	}
+
+	return &Array[T]{
+		data:    unsafe.Pointer(a.ptr(lo)),
+		size:    hi - lo,
+		backing: a.backing[findOffset[T](lo):findOffset[T](hi)],
+	}
+}
+
+func (a *Array[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range a.Indexed() {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+func (a *Array[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range a.Len() {
+			if !yield(i, *a.ptr(i)) {
+				return
+			}
+		}
+	}
 }
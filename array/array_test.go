@@ -1,7 +1,10 @@
 package array
 
 import (
+	"errors"
 	"runtime"
+	"slices"
+	"strconv"
 	"testing"
 	"unsafe"
 )
@@ -195,6 +198,204 @@ func TestSetBoundsCheck(t *testing.T) {
 	}
 }
 
+func TestLen(t *testing.T) {
+	cases := []struct {
+		name string
+		arr  *Array[int]
+		want int
+	}{
+		{
+			name: "nil array",
+			arr:  nil,
+			want: 0,
+		}, {
+			name: "zero size array",
+			arr:  NewArray[int](0),
+			want: 0,
+		}, {
+			name: "non-empty array",
+			arr:  NewArray[int](7),
+			want: 7,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.arr.Len(); got != tt.want {
+				t.Errorf("got: %d; expected: %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAtSetAt(t *testing.T) {
+	arr := NewArray[int](3)
+
+	cases := []struct {
+		name    string
+		index   int
+		wantErr bool
+	}{
+		{
+			name:  "first index",
+			index: 0,
+		}, {
+			name:  "last index",
+			index: 2,
+		}, {
+			name:    "negative index",
+			index:   -1,
+			wantErr: true,
+		}, {
+			name:    "index equal to len",
+			index:   3,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := arr.SetAt(tt.index, 42)
+			_, atErr := arr.At(tt.index)
+
+			if !tt.wantErr {
+				if err != nil || atErr != nil {
+					t.Fatalf("got errors %v, %v; want nil", err, atErr)
+				}
+				if got, _ := arr.At(tt.index); got != 42 {
+					t.Errorf("got: %d; expected: 42", got)
+				}
+				return
+			}
+
+			for _, err := range []error{err, atErr} {
+				var idxErr IndexError
+				if !errors.As(err, &idxErr) {
+					t.Fatalf("got error %v; want IndexError", err)
+				}
+				if idxErr.Index != tt.index || idxErr.Len != 3 {
+					t.Errorf("got %+v; want index %d len 3", idxErr, tt.index)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPanicsWithIndexError(t *testing.T) {
+	arr := NewArray[int](3)
+
+	defer func() {
+		err, ok := recover().(IndexError)
+		if !ok {
+			t.Fatal("expected to panic with IndexError")
+		}
+		if err.Index != 5 || err.Len != 3 {
+			t.Errorf("got %+v; want index 5 len 3", err)
+		}
+	}()
+
+	arr.Get(5)
+}
+
+func TestFillCopyFromSwap(t *testing.T) {
+	arr := NewArray[int](5)
+
+	arr.Fill(7)
+	if got, want := slices.Collect(arr.All()), []int{7, 7, 7, 7, 7}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	if n := arr.CopyFrom([]int{1, 2, 3}); n != 3 {
+		t.Errorf("copied %d elements; expected 3", n)
+	}
+	if got, want := slices.Collect(arr.All()), []int{1, 2, 3, 7, 7}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	if n := arr.CopyFrom([]int{9, 8, 7, 6, 5, 4}); n != 5 {
+		t.Errorf("copied %d elements; expected 5", n)
+	}
+
+	arr.Swap(0, 4)
+	if got, want := slices.Collect(arr.All()), []int{5, 8, 7, 6, 9}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Fatal("expected panic but got nil")
+		}
+	}()
+	arr.Swap(0, 5)
+}
+
+func TestSlice(t *testing.T) {
+	arr := NewArray[int](6)
+	arr.CopyFrom([]int{0, 1, 2, 3, 4, 5})
+
+	view := arr.Slice(2, 5)
+	if view.Len() != 3 {
+		t.Fatalf("expected len 3; got %d", view.Len())
+	}
+	if got, want := slices.Collect(view.All()), []int{2, 3, 4}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	view.Set(20, 0)
+	if got := arr.Get(2); got != 20 {
+		t.Errorf("write through view not visible; got %d", got)
+	}
+
+	arr.Set(40, 4)
+	if got := view.Get(2); got != 40 {
+		t.Errorf("write through array not visible in view; got %d", got)
+	}
+
+	if _, err := view.At(3); err == nil {
+		t.Error("expected view to be bounded to its own length")
+	}
+
+	if len(view.backing) != int(unsafe.Sizeof(int(0)))*3 {
+		t.Errorf("got view backing size %d", len(view.backing))
+	}
+
+	if empty := arr.Slice(6, 6); empty.Len() != 0 {
+		t.Errorf("expected empty view; got len %d", empty.Len())
+	}
+}
+
+func TestSliceBoundsCheck(t *testing.T) {
+	arr := NewArray[int](3)
+	cases := []struct {
+		name   string
+		lo, hi int
+	}{
+		{
+			name: "negative lo",
+			lo:   -1,
+			hi:   2,
+		}, {
+			name: "hi past len",
+			lo:   0,
+			hi:   4,
+		}, {
+			name: "lo past hi",
+			lo:   2,
+			hi:   1,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if _, ok := recover().(IndexError); !ok {
+					t.Fatal("expected to panic with IndexError")
+				}
+			}()
+			arr.Slice(tt.lo, tt.hi)
+		})
+	}
+}
+
 func TestMemoryIsAlive(t *testing.T) {
 	elements := 1000
 	arr := NewArray[int](elements)
@@ -214,6 +415,109 @@ func TestMemoryIsAlive(t *testing.T) {
 	}
 }
 
+func TestMemoryIsAlivePointers(t *testing.T) {
+	type node struct {
+		val  int
+		next *node
+	}
+
+	elements := 1000
+	ptrs := NewArray[*node](elements)
+	strs := NewArray[string](elements)
+	slcs := NewArray[[]int](elements)
+	ifaces := NewArray[any](elements)
+
+	for i := range elements {
+		ptrs.Set(&node{val: i, next: &node{val: -i}}, i)
+		strs.Set(strconv.Itoa(i)+"-gopher", i)
+		slcs.Set([]int{i, i * i}, i)
+		ifaces.Set(&node{val: i}, i)
+	}
+
+	for range 5 {
+		_ = make([]*node, 10000)
+		runtime.GC()
+	}
+
+	for i := range elements {
+		if got := ptrs.Get(i); got.val != i || got.next.val != -i {
+			t.Fatalf("pointer in index %d got: %d, %d; want: %d, %d", i, got.val, got.next.val, i, -i)
+		}
+		if got, want := strs.Get(i), strconv.Itoa(i)+"-gopher"; got != want {
+			t.Fatalf("string in index %d got: %q; want: %q", i, got, want)
+		}
+		if got := slcs.Get(i); len(got) != 2 || got[0] != i || got[1] != i*i {
+			t.Fatalf("slice in index %d got: %v; want: [%d %d]", i, got, i, i*i)
+		}
+		if got := ifaces.Get(i).(*node); got.val != i {
+			t.Fatalf("interface in index %d got: %d; want: %d", i, got.val, i)
+		}
+	}
+}
+
+func TestAlignment(t *testing.T) {
+	type padded struct {
+		b byte
+		x int64
+	}
+
+	checkAlignment(t, NewArray[byte](3))
+	checkAlignment(t, NewArray[int32](1))
+	checkAlignment(t, NewArray[int64](1))
+	checkAlignment(t, NewArray[complex128](3))
+	checkAlignment(t, NewArray[padded](5))
+}
+
+func TestZeroSizeElements(t *testing.T) {
+	arr := NewArray[struct{}](3)
+
+	arr.Set(struct{}{}, 2)
+	_ = arr.Get(2)
+
+	if arr.size != 3 {
+		t.Errorf("expected size 3; got %d", arr.size)
+	}
+}
+
+func TestArrayIterators(t *testing.T) {
+	vals := []int{4, 8, 15, 16, 23, 42}
+	arr := NewArray[int](len(vals))
+	for i, v := range vals {
+		arr.Set(v, i)
+	}
+
+	if got := slices.Collect(arr.All()); !slices.Equal(got, vals) {
+		t.Errorf("All: got %v; want %v", got, vals)
+	}
+
+	for i, v := range arr.Indexed() {
+		if v != vals[i] {
+			t.Errorf("Indexed: in index %d, got: %d; expected: %d", i, v, vals[i])
+		}
+		if i == 2 {
+			break
+		}
+	}
+
+	var nilArr *Array[int]
+	if got := slices.Collect(nilArr.All()); len(got) != 0 {
+		t.Errorf("nil array: got %v; want empty", got)
+	}
+
+	if got := slices.Collect(NewArray[int](0).All()); len(got) != 0 {
+		t.Errorf("zero size array: got %v; want empty", got)
+	}
+}
+
+func checkAlignment[T any](t *testing.T, arr *Array[T]) {
+	t.Helper()
+
+	var elem T
+	if align := unsafe.Alignof(elem); uintptr(arr.data)%align != 0 {
+		t.Errorf("%T data at %p is not aligned to %d", elem, arr.data, align)
+	}
+}
+
 func BenchmarkArraySet(b *testing.B) {
 	arr := NewArray[int](1000)
 	for i := 0; i < b.N; i++ {
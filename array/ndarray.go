@@ -0,0 +1,269 @@
+package array
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Layout decides how a freshly allocated ArrayND maps indices to memory.
+type Layout int
+
+const (
+	// RowMajor keeps the last index contiguous, like a Go [][]T.
+	RowMajor Layout = iota
+	// ColMajor keeps the first index contiguous, like Fortran or BLAS.
+	ColMajor
+)
+
+// ArrayND is an n-dimensional view over an Array. An element's position in
+// the backing Array is offset plus the sum of each index times its stride,
+// so transposing or slicing only changes the metadata and never copies.
+type ArrayND[T any] struct {
+	data    *Array[T]
+	offset  int
+	shape   []int
+	strides []int
+}
+
+func NewArrayND[T any](layout Layout, shape ...int) *ArrayND[T] {
+	size := 1
+	for _, dim := range shape {
+		if dim < 0 {
+			panic(fmt.Sprintf("array: negative dimension %d", dim))
+		}
+		size *= dim
+	}
+
+	return &ArrayND[T]{
+		data:    NewArray[T](size),
+		shape:   slices.Clone(shape),
+		strides: contiguousStrides(layout, shape),
+	}
+}
+
+func contiguousStrides(layout Layout, shape []int) []int {
+	strides := make([]int, len(shape))
+	stride := 1
+	if layout == ColMajor {
+		for k := range shape {
+			strides[k] = stride
+			stride *= shape[k]
+		}
+		return strides
+	}
+
+	for k := len(shape) - 1; k >= 0; k-- {
+		strides[k] = stride
+		stride *= shape[k]
+	}
+	return strides
+}
+
+// Dims returns the number of dimensions.
+func (a *ArrayND[T]) Dims() int {
+	if a == nil {
+		return 0
+	}
+	return len(a.shape)
+}
+
+func (a *ArrayND[T]) Shape() []int {
+	if a == nil {
+		return nil
+	}
+	return slices.Clone(a.shape)
+}
+
+// Strides returns how many backing elements each index steps over.
+func (a *ArrayND[T]) Strides() []int {
+	if a == nil {
+		return nil
+	}
+	return slices.Clone(a.strides)
+}
+
+// Len returns the total number of elements in the view.
+func (a *ArrayND[T]) Len() int {
+	if a == nil {
+		return 0
+	}
+
+	size := 1
+	for _, dim := range a.shape {
+		size *= dim
+	}
+	return size
+}
+
+func (a *ArrayND[T]) index(idx []int) int {
+	if len(idx) != a.Dims() {
+		panic(fmt.Sprintf("array: got %d indices for %d dimensions", len(idx), a.Dims()))
+	}
+
+	pos := a.offset
+	for k, i := range idx {
+		if i < 0 || i >= a.shape[k] {
+			panic(IndexError{Index: i, Len: a.shape[k]})
+		}
+		pos += i * a.strides[k]
+	}
+	return pos
+}
+
+// At returns the element at idx and panics with an IndexError if any index
+// is out of bounds.
+func (a *ArrayND[T]) At(idx ...int) T {
+	return a.data.Get(a.index(idx))
+}
+
+func (a *ArrayND[T]) Set(val T, idx ...int) {
+	a.data.Set(val, a.index(idx))
+}
+
+// Transpose returns a view with the order of the axes reversed.
+func (a *ArrayND[T]) Transpose() *ArrayND[T] {
+	t := a.view()
+	slices.Reverse(t.shape)
+	slices.Reverse(t.strides)
+	return t
+}
+
+// Index returns the (n-1)-dimensional view obtained by fixing axis to i.
+func (a *ArrayND[T]) Index(axis, i int) *ArrayND[T] {
+	if axis < 0 || axis >= a.Dims() {
+		panic(fmt.Sprintf("array: axis %d out of range for %d dimensions", axis, a.Dims()))
+	}
+	if i < 0 || i >= a.shape[axis] {
+		panic(IndexError{Index: i, Len: a.shape[axis]})
+	}
+
+	v := a.view()
+	v.offset += i * a.strides[axis]
+	v.shape = slices.Delete(v.shape, axis, axis+1)
+	v.strides = slices.Delete(v.strides, axis, axis+1)
+	return v
+}
+
+// Sub returns the view of the elements whose k-th index is in [lo[k], hi[k]).
+func (a *ArrayND[T]) Sub(lo, hi []int) *ArrayND[T] {
+	if len(lo) != a.Dims() || len(hi) != a.Dims() {
+		panic(fmt.Sprintf("array: got %d and %d bounds for %d dimensions", len(lo), len(hi), a.Dims()))
+	}
+
+	v := a.view()
+	for k := range a.shape {
+		if hi[k] < 0 || hi[k] > a.shape[k] {
+			panic(IndexError{Index: hi[k], Len: a.shape[k]})
+		}
+		if lo[k] < 0 || lo[k] > hi[k] {
+			panic(IndexError{Index: lo[k], Len: hi[k]})
+		}
+		v.offset += lo[k] * a.strides[k]
+		v.shape[k] = hi[k] - lo[k]
+	}
+	return v
+}
+
+// All yields every element with the last index changing fastest, regardless
+// of the memory layout.
+func (a *ArrayND[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if a.Len() == 0 {
+			return
+		}
+
+		idx := make([]int, a.Dims())
+		for {
+			if !yield(a.data.Get(a.index(idx))) {
+				return
+			}
+
+			k := len(idx) - 1
+			for ; k >= 0; k-- {
+				idx[k]++
+				if idx[k] < a.shape[k] {
+					break
+				}
+				idx[k] = 0
+			}
+			if k < 0 {
+				return
+			}
+		}
+	}
+}
+
+func (a *ArrayND[T]) view() *ArrayND[T] {
+	return &ArrayND[T]{
+		data:    a.data,
+		offset:  a.offset,
+		shape:   slices.Clone(a.shape),
+		strides: slices.Clone(a.strides),
+	}
+}
+
+// Array2D is a matrix view over an Array. A nil or zero-value Array2D is an
+// empty 0x0 matrix.
+type Array2D[T any] struct {
+	nd *ArrayND[T]
+}
+
+func NewArray2D[T any](rows, cols int, layout Layout) *Array2D[T] {
+	return &Array2D[T]{
+		nd: NewArrayND[T](layout, rows, cols),
+	}
+}
+
+// matrix returns the view m wraps, or an empty 0x0 view if there is none.
+func (m *Array2D[T]) matrix() *ArrayND[T] {
+	if m == nil || m.nd == nil {
+		return &ArrayND[T]{shape: []int{0, 0}, strides: []int{0, 0}}
+	}
+	return m.nd
+}
+
+func (m *Array2D[T]) Rows() int {
+	return m.matrix().shape[0]
+}
+
+func (m *Array2D[T]) Cols() int {
+	return m.matrix().shape[1]
+}
+
+func (m *Array2D[T]) At(i, j int) T {
+	return m.matrix().At(i, j)
+}
+
+func (m *Array2D[T]) Set(val T, i, j int) {
+	m.matrix().Set(val, i, j)
+}
+
+// Row returns a one-dimensional view of row i.
+func (m *Array2D[T]) Row(i int) *ArrayND[T] {
+	return m.matrix().Index(0, i)
+}
+
+// Col returns a one-dimensional view of column j.
+func (m *Array2D[T]) Col(j int) *ArrayND[T] {
+	return m.matrix().Index(1, j)
+}
+
+// Transpose returns a view of the transposed matrix without copying.
+func (m *Array2D[T]) Transpose() *Array2D[T] {
+	return &Array2D[T]{nd: m.matrix().Transpose()}
+}
+
+// Sub returns the view of rows [r0, r1) and columns [c0, c1).
+func (m *Array2D[T]) Sub(r0, r1, c0, c1 int) *Array2D[T] {
+	return &Array2D[T]{nd: m.matrix().Sub([]int{r0, c0}, []int{r1, c1})}
+}
+
+// ND returns the matrix as a general n-dimensional view.
+func (m *Array2D[T]) ND() *ArrayND[T] {
+	return m.matrix()
+}
+
+func (m *Array2D[T]) All() iter.Seq[T] {
+	return m.matrix().All()
+}
@@ -0,0 +1,232 @@
+package array
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewArrayND(t *testing.T) {
+	cases := []struct {
+		name        string
+		layout      Layout
+		shape       []int
+		wantStrides []int
+		wantLen     int
+	}{
+		{
+			name:        "row major matrix",
+			layout:      RowMajor,
+			shape:       []int{2, 3},
+			wantStrides: []int{3, 1},
+			wantLen:     6,
+		}, {
+			name:        "col major matrix",
+			layout:      ColMajor,
+			shape:       []int{2, 3},
+			wantStrides: []int{1, 2},
+			wantLen:     6,
+		}, {
+			name:        "row major cube",
+			layout:      RowMajor,
+			shape:       []int{2, 3, 4},
+			wantStrides: []int{12, 4, 1},
+			wantLen:     24,
+		}, {
+			name:        "empty dimension",
+			layout:      RowMajor,
+			shape:       []int{3, 0},
+			wantStrides: []int{0, 1},
+			wantLen:     0,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := NewArrayND[int](tt.layout, tt.shape...)
+
+			if got := arr.Shape(); !slices.Equal(got, tt.shape) {
+				t.Errorf("got shape %v; want %v", got, tt.shape)
+			}
+			if got := arr.Strides(); !slices.Equal(got, tt.wantStrides) {
+				t.Errorf("got strides %v; want %v", got, tt.wantStrides)
+			}
+			if arr.Len() != tt.wantLen || arr.data.Len() != tt.wantLen {
+				t.Errorf("got len %d with backing %d; want %d", arr.Len(), arr.data.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestArrayNDAtSet(t *testing.T) {
+	for _, layout := range []Layout{RowMajor, ColMajor} {
+		arr := NewArrayND[int](layout, 2, 3, 4)
+		for i := range 2 {
+			for j := range 3 {
+				for k := range 4 {
+					arr.Set(100*i+10*j+k, i, j, k)
+				}
+			}
+		}
+
+		for i := range 2 {
+			for j := range 3 {
+				for k := range 4 {
+					if got, want := arr.At(i, j, k), 100*i+10*j+k; got != want {
+						t.Fatalf("layout %d at (%d, %d, %d) got: %d; want: %d", layout, i, j, k, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestArrayNDBoundsCheck(t *testing.T) {
+	arr := NewArrayND[int](RowMajor, 2, 3)
+	cases := []struct {
+		name string
+		idx  []int
+	}{
+		{
+			name: "row out of range",
+			idx:  []int{2, 0},
+		}, {
+			name: "negative col",
+			idx:  []int{0, -1},
+		}, {
+			name: "too few indices",
+			idx:  []int{1},
+		}, {
+			name: "too many indices",
+			idx:  []int{1, 1, 1},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Fatal("expected panic but got nil")
+				}
+			}()
+			arr.At(tt.idx...)
+		})
+	}
+}
+
+func TestArray2DViews(t *testing.T) {
+	for _, layout := range []Layout{RowMajor, ColMajor} {
+		m := fromRows(t, layout, [][]int{
+			{1, 2, 3},
+			{4, 5, 6},
+		})
+
+		if got, want := slices.Collect(m.All()), []int{1, 2, 3, 4, 5, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d: got %v; want %v", layout, got, want)
+		}
+
+		if got, want := slices.Collect(m.Row(1).All()), []int{4, 5, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d row: got %v; want %v", layout, got, want)
+		}
+
+		if got, want := slices.Collect(m.Col(2).All()), []int{3, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d col: got %v; want %v", layout, got, want)
+		}
+
+		tr := m.Transpose()
+		if tr.Rows() != 3 || tr.Cols() != 2 {
+			t.Fatalf("layout %d: got transposed shape %dx%d; want 3x2", layout, tr.Rows(), tr.Cols())
+		}
+		if got, want := slices.Collect(tr.All()), []int{1, 4, 2, 5, 3, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d transpose: got %v; want %v", layout, got, want)
+		}
+
+		sub := m.Sub(0, 2, 1, 3)
+		if got, want := slices.Collect(sub.All()), []int{2, 3, 5, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d sub: got %v; want %v", layout, got, want)
+		}
+
+		sub.Set(50, 1, 0)
+		tr.Row(2).Set(30, 0)
+		m.Col(0).Set(10, 0)
+
+		if got, want := slices.Collect(m.All()), []int{10, 2, 30, 4, 50, 6}; !slices.Equal(got, want) {
+			t.Errorf("layout %d: writes through views not shared; got %v; want %v", layout, got, want)
+		}
+	}
+}
+
+func TestArray2DZeroValue(t *testing.T) {
+	for _, m := range []*Array2D[int]{nil, {}} {
+		if m.Rows() != 0 || m.Cols() != 0 {
+			t.Errorf("got shape %dx%d; want 0x0", m.Rows(), m.Cols())
+		}
+		if got := slices.Collect(m.All()); len(got) != 0 {
+			t.Errorf("got %v; want no elements", got)
+		}
+		if tr := m.Transpose(); tr.Rows() != 0 || tr.Cols() != 0 {
+			t.Errorf("got transposed shape %dx%d; want 0x0", tr.Rows(), tr.Cols())
+		}
+		if sub := m.Sub(0, 0, 0, 0); sub.Rows() != 0 || sub.Cols() != 0 {
+			t.Errorf("got sub shape %dx%d; want 0x0", sub.Rows(), sub.Cols())
+		}
+
+		for name, access := range map[string]func(){
+			"At":  func() { m.At(0, 0) },
+			"Set": func() { m.Set(1, 0, 0) },
+			"Row": func() { m.Row(0) },
+			"Col": func() { m.Col(0) },
+		} {
+			func() {
+				defer func() {
+					if _, ok := recover().(IndexError); !ok {
+						t.Errorf("%s: expected to panic with IndexError", name)
+					}
+				}()
+				access()
+			}()
+		}
+	}
+}
+
+func TestArrayNDIndexAndSub(t *testing.T) {
+	arr := NewArrayND[int](RowMajor, 2, 3, 4)
+	for i := range arr.Len() {
+		arr.data.Set(i, i)
+	}
+
+	plane := arr.Index(1, 2)
+	if got, want := plane.Shape(), []int{2, 4}; !slices.Equal(got, want) {
+		t.Fatalf("got shape %v; want %v", got, want)
+	}
+	if got, want := slices.Collect(plane.All()), []int{8, 9, 10, 11, 20, 21, 22, 23}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+
+	sub := arr.Sub([]int{1, 0, 1}, []int{2, 2, 3})
+	if got, want := slices.Collect(sub.All()), []int{13, 14, 17, 18}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+
+	if got, want := arr.Transpose().At(3, 2, 1), arr.At(1, 2, 3); got != want {
+		t.Errorf("got %d; want %d", got, want)
+	}
+
+	defer func() {
+		if _, ok := recover().(IndexError); !ok {
+			t.Fatal("expected to panic with IndexError")
+		}
+	}()
+	arr.Sub([]int{0, 0, 0}, []int{2, 4, 4})
+}
+
+func fromRows(t *testing.T, layout Layout, rows [][]int) *Array2D[int] {
+	t.Helper()
+
+	m := NewArray2D[int](len(rows), len(rows[0]), layout)
+	for i, row := range rows {
+		for j, val := range row {
+			m.Set(val, i, j)
+		}
+	}
+	return m
+}
@@ -0,0 +1,179 @@
+package array
+
+import (
+	"iter"
+	"math"
+)
+
+const defaultGrowthFactor = 2.0
+
+// Vector is a dynamic array that stores its elements in an Array and
+// reallocates it when it runs out of room.
+type Vector[T any] struct {
+	data   *Array[T]
+	length int
+	growth float64
+}
+
+func NewVector[T any](capacity int) *Vector[T] {
+	return &Vector[T]{
+		data:   NewArray[T](capacity),
+		growth: defaultGrowthFactor,
+	}
+}
+
+// SetGrowthFactor sets how much the capacity is multiplied by when the vector
+// grows. The factor must be greater than 1.
+func (v *Vector[T]) SetGrowthFactor(factor float64) {
+	if v == nil {
+		return
+	}
+	if factor <= 1 || math.IsNaN(factor) || math.IsInf(factor, 0) {
+		panic("array: growth factor must be a finite number greater than 1")
+	}
+	v.growth = factor
+}
+
+func (v *Vector[T]) Len() int {
+	if v == nil {
+		return 0
+	}
+	return v.length
+}
+
+func (v *Vector[T]) Cap() int {
+	if v == nil {
+		return 0
+	}
+	return v.data.Len()
+}
+
+func (v *Vector[T]) IsEmpty() bool {
+	return v.Len() == 0
+}
+
+func (v *Vector[T]) Get(i int) T {
+	if i < 0 || i >= v.Len() {
+		panic(IndexError{Index: i, Len: v.Len()})
+	}
+	return v.data.Get(i)
+}
+
+func (v *Vector[T]) Set(val T, i int) {
+	if i < 0 || i >= v.Len() {
+		panic(IndexError{Index: i, Len: v.Len()})
+	}
+	v.data.Set(val, i)
+}
+
+func (v *Vector[T]) Append(vals ...T) {
+	if v == nil {
+		return
+	}
+
+	v.grow(len(vals))
+	for _, val := range vals {
+		v.data.Set(val, v.length)
+		v.length++
+	}
+}
+
+// Insert places val at index i, shifting the elements from i onward one
+// position to the right. i may equal Len to append.
+func (v *Vector[T]) Insert(i int, val T) {
+	if i < 0 || i > v.Len() {
+		panic(IndexError{Index: i, Len: v.Len()})
+	}
+	if v == nil {
+		return
+	}
+
+	v.grow(1)
+	for j := v.length; j > i; j-- {
+		v.data.Set(v.data.Get(j-1), j)
+	}
+	v.data.Set(val, i)
+	v.length++
+}
+
+// RemoveAt removes and returns the element at index i, shifting the elements
+// after it one position to the left.
+func (v *Vector[T]) RemoveAt(i int) T {
+	if i < 0 || i >= v.Len() {
+		panic(IndexError{Index: i, Len: v.Len()})
+	}
+
+	val := v.data.Get(i)
+	for j := i; j < v.length-1; j++ {
+		v.data.Set(v.data.Get(j+1), j)
+	}
+	v.length--
+
+	var zero T
+	v.data.Set(zero, v.length)
+	return val
+}
+
+// Reserve makes sure the vector can hold at least capacity elements without
+// reallocating.
+func (v *Vector[T]) Reserve(capacity int) {
+	if v == nil || capacity <= v.Cap() {
+		return
+	}
+	v.realloc(capacity)
+}
+
+// ShrinkToFit reallocates the backing array so that Cap equals Len.
+func (v *Vector[T]) ShrinkToFit() {
+	if v == nil || v.length == v.Cap() {
+		return
+	}
+	v.realloc(v.length)
+}
+
+func (v *Vector[T]) ToSlice() []T {
+	ret := make([]T, 0, v.Len())
+	for val := range v.All() {
+		ret = append(ret, val)
+	}
+	return ret
+}
+
+func (v *Vector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range v.Len() {
+			if !yield(v.data.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// grow makes room for n more elements, multiplying the capacity by the growth
+// factor until they fit. A zero-value Vector has no factor set and uses the
+// default.
+func (v *Vector[T]) grow(n int) {
+	need := v.length + n
+	if need <= v.Cap() {
+		return
+	}
+
+	growth := v.growth
+	if growth <= 1 {
+		growth = defaultGrowthFactor
+	}
+
+	capacity := v.Cap()
+	for capacity < need {
+		capacity = max(int(math.Ceil(float64(capacity)*growth)), capacity+1)
+	}
+	v.realloc(capacity)
+}
+
+func (v *Vector[T]) realloc(capacity int) {
+	data := NewArray[T](capacity)
+	for i := range v.length {
+		data.Set(v.data.Get(i), i)
+	}
+	v.data = data
+}
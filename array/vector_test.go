@@ -0,0 +1,220 @@
+package array
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewVector(t *testing.T) {
+	vec := NewVector[int](4)
+
+	if vec == nil {
+		t.Fatal("failed to allocate vector")
+	}
+
+	if vec.Len() != 0 {
+		t.Errorf("expected len 0; got %d", vec.Len())
+	}
+
+	if vec.Cap() != 4 {
+		t.Errorf("expected cap 4; got %d", vec.Cap())
+	}
+}
+
+func TestVectorAppend(t *testing.T) {
+	cases := []struct {
+		name    string
+		base    int
+		growth  float64
+		vals    []int
+		wantCap int
+	}{
+		{
+			name:    "within capacity",
+			base:    4,
+			growth:  2,
+			vals:    []int{1, 2, 3},
+			wantCap: 4,
+		}, {
+			name:    "double on overflow",
+			base:    2,
+			growth:  2,
+			vals:    []int{1, 2, 3},
+			wantCap: 4,
+		}, {
+			name:    "grow from zero capacity",
+			base:    0,
+			growth:  1.5,
+			vals:    []int{1, 2, 3, 4},
+			wantCap: 5,
+		}, {
+			name:    "small growth factor still grows",
+			base:    1,
+			growth:  1.01,
+			vals:    []int{1, 2, 3},
+			wantCap: 3,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			vec := NewVector[int](tt.base)
+			vec.SetGrowthFactor(tt.growth)
+
+			for _, val := range tt.vals {
+				vec.Append(val)
+			}
+
+			if got := vec.ToSlice(); !slices.Equal(got, tt.vals) {
+				t.Errorf("got %v; want %v", got, tt.vals)
+			}
+
+			if vec.Len() != len(tt.vals) {
+				t.Errorf("expected len %d; got %d", len(tt.vals), vec.Len())
+			}
+
+			if vec.Cap() != tt.wantCap {
+				t.Errorf("expected cap %d; got %d", tt.wantCap, vec.Cap())
+			}
+		})
+	}
+}
+
+func TestVectorInsertRemove(t *testing.T) {
+	vec := NewVector[string](0)
+	vec.Append("b", "d")
+
+	vec.Insert(0, "a")
+	vec.Insert(2, "c")
+	vec.Insert(vec.Len(), "e")
+
+	if got, want := vec.ToSlice(), []string{"a", "b", "c", "d", "e"}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	if got := vec.RemoveAt(2); got != "c" {
+		t.Errorf("got %q; want %q", got, "c")
+	}
+	if got := vec.RemoveAt(vec.Len() - 1); got != "e" {
+		t.Errorf("got %q; want %q", got, "e")
+	}
+	if got := vec.RemoveAt(0); got != "a" {
+		t.Errorf("got %q; want %q", got, "a")
+	}
+
+	if got, want := vec.ToSlice(), []string{"b", "d"}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	vec.Set("z", 1)
+	if got := vec.Get(1); got != "z" {
+		t.Errorf("got %q; want %q", got, "z")
+	}
+}
+
+func TestVectorReserveShrink(t *testing.T) {
+	vec := NewVector[int](1)
+
+	vec.Reserve(100)
+	if vec.Cap() != 100 {
+		t.Fatalf("expected cap 100; got %d", vec.Cap())
+	}
+
+	vec.Append(1, 2, 3)
+	vec.Reserve(10)
+	if vec.Cap() != 100 {
+		t.Fatalf("reserve should not shrink; got cap %d", vec.Cap())
+	}
+
+	vec.ShrinkToFit()
+	if vec.Cap() != 3 {
+		t.Fatalf("expected cap 3; got %d", vec.Cap())
+	}
+
+	if got, want := vec.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
+
+func TestVectorBoundsCheck(t *testing.T) {
+	cases := []struct {
+		name string
+		do   func(*Vector[int])
+	}{
+		{
+			name: "get past len",
+			do:   func(v *Vector[int]) { v.Get(3) },
+		}, {
+			name: "set negative",
+			do:   func(v *Vector[int]) { v.Set(1, -1) },
+		}, {
+			name: "insert past len",
+			do:   func(v *Vector[int]) { v.Insert(4, 1) },
+		}, {
+			name: "remove past len",
+			do:   func(v *Vector[int]) { v.RemoveAt(3) },
+		}, {
+			name: "growth factor of one",
+			do:   func(v *Vector[int]) { v.SetGrowthFactor(1) },
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			vec := NewVector[int](10)
+			vec.Append(1, 2, 3)
+
+			defer func() {
+				if err := recover(); err == nil {
+					t.Fatal("expected panic but got nil")
+				}
+			}()
+			tt.do(vec)
+		})
+	}
+}
+
+func TestVectorEdge(t *testing.T) {
+	var vec *Vector[int]
+
+	defer func() {
+		if err := recover(); err != nil {
+			t.Error("expected not to panic but did")
+		}
+	}()
+
+	vec.Append(1)
+	vec.Insert(0, 1)
+	vec.Reserve(10)
+	vec.ShrinkToFit()
+
+	if vec.Len() != 0 || vec.Cap() != 0 || len(vec.ToSlice()) != 0 {
+		t.Error("expected nil vector to be empty")
+	}
+}
+
+func TestVectorZeroValue(t *testing.T) {
+	var vec Vector[int]
+
+	var caps []int
+	for i := range 9 {
+		vec.Append(i)
+		if len(caps) == 0 || caps[len(caps)-1] != vec.Cap() {
+			caps = append(caps, vec.Cap())
+		}
+	}
+
+	if want := []int{1, 2, 4, 8, 16}; !slices.Equal(caps, want) {
+		t.Errorf("got capacities %v; want %v", caps, want)
+	}
+	if got := vec.ToSlice(); !slices.Equal(got, []int{0, 1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func BenchmarkVectorAppend(b *testing.B) {
+	vec := NewVector[int](0)
+	for i := 0; i < b.N; i++ {
+		vec.Append(i)
+	}
+}
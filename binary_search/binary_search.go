@@ -1,6 +1,6 @@
 package binarysearch
 
-func BinarySearch[S ~[]T, T comparable](needle T, haystack S, comp func(T, T) int) (int, bool) {
+func BinarySearch[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) (int, bool) {
 	start := 0
 	end := len(haystack) - 1
 
@@ -124,3 +124,23 @@ func TestBinarySearchEmptyArr(t *testing.T) {
 		}
 	}
 }
+
+func TestBinarySearchNonComparable(t *testing.T) {
+	type record struct {
+		key    int
+		values map[string]int
+	}
+
+	arr := []record{{key: 1}, {key: 4}, {key: 9}}
+	comp := func(a, b record) int {
+		return cmp.Compare(a.key, b.key)
+	}
+
+	if idx, ok := BinarySearch(record{key: 4}, arr, comp); !ok || idx != 1 {
+		t.Errorf("got (%d, %t); want (1, true)", idx, ok)
+	}
+
+	if _, ok := BinarySearch(record{key: 5}, arr, comp); ok {
+		t.Error("expected 5 not to be found")
+	}
+}
@@ -0,0 +1,68 @@
+package binarysearch
+
+// LowerBound returns the index of the first element of haystack that is not
+// less than needle, or len(haystack) if there is none.
+func LowerBound[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) int {
+	start, end := 0, len(haystack)
+
+	for start < end {
+		mid := (end-start)/2 + start
+		if comp(haystack[mid], needle) < 0 {
+			start = mid + 1
+		} else {
+			end = mid
+		}
+	}
+	return start
+}
+
+// UpperBound returns the index of the first element of haystack that is
+// greater than needle, or len(haystack) if there is none.
+func UpperBound[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) int {
+	start, end := 0, len(haystack)
+
+	for start < end {
+		mid := (end-start)/2 + start
+		if comp(haystack[mid], needle) <= 0 {
+			start = mid + 1
+		} else {
+			end = mid
+		}
+	}
+	return start
+}
+
+// EqualRange returns the half-open range [lo, hi) of elements equal to
+// needle. The range is empty, with lo == hi at the insertion point, when
+// needle is not present.
+func EqualRange[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) (int, int) {
+	lo := LowerBound(needle, haystack, comp)
+	hi := lo + UpperBound(needle, haystack[lo:], comp)
+	return lo, hi
+}
+
+// InsertionPoint returns the index at which needle would be inserted to keep
+// haystack sorted, before any equal elements, and whether needle is present.
+func InsertionPoint[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) (int, bool) {
+	i := LowerBound(needle, haystack, comp)
+	return i, i < len(haystack) && comp(haystack[i], needle) == 0
+}
+
+// FirstOccurrence returns the index of the first element equal to needle.
+// Like BinarySearch it returns 0, false on a miss.
+func FirstOccurrence[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) (int, bool) {
+	if i, ok := InsertionPoint(needle, haystack, comp); ok {
+		return i, true
+	}
+	return 0, false
+}
+
+// LastOccurrence returns the index of the last element equal to needle.
+// Like BinarySearch it returns 0, false on a miss.
+func LastOccurrence[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) (int, bool) {
+	i := UpperBound(needle, haystack, comp) - 1
+	if i >= 0 && comp(haystack[i], needle) == 0 {
+		return i, true
+	}
+	return 0, false
+}
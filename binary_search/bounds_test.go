@@ -0,0 +1,106 @@
+package binarysearch
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestBounds(t *testing.T) {
+	arr := []int{1, 3, 3, 3, 5, 8, 8, 13}
+	cases := []struct {
+		name      string
+		toFind    int
+		wantLower int
+		wantUpper int
+	}{
+		{
+			name:      "below all",
+			toFind:    0,
+			wantLower: 0,
+			wantUpper: 0,
+		}, {
+			name:      "first element",
+			toFind:    1,
+			wantLower: 0,
+			wantUpper: 1,
+		}, {
+			name:      "run of duplicates",
+			toFind:    3,
+			wantLower: 1,
+			wantUpper: 4,
+		}, {
+			name:      "missing in the middle",
+			toFind:    4,
+			wantLower: 4,
+			wantUpper: 4,
+		}, {
+			name:      "duplicates at the end",
+			toFind:    8,
+			wantLower: 5,
+			wantUpper: 7,
+		}, {
+			name:      "above all",
+			toFind:    14,
+			wantLower: 8,
+			wantUpper: 8,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LowerBound(tt.toFind, arr, cmp.Compare[int]); got != tt.wantLower {
+				t.Errorf("LowerBound: got %d; want %d", got, tt.wantLower)
+			}
+			if got := UpperBound(tt.toFind, arr, cmp.Compare[int]); got != tt.wantUpper {
+				t.Errorf("UpperBound: got %d; want %d", got, tt.wantUpper)
+			}
+
+			lo, hi := EqualRange(tt.toFind, arr, cmp.Compare[int])
+			if lo != tt.wantLower || hi != tt.wantUpper {
+				t.Errorf("EqualRange: got [%d, %d); want [%d, %d)", lo, hi, tt.wantLower, tt.wantUpper)
+			}
+
+			found := tt.wantLower != tt.wantUpper
+			idx, ok := InsertionPoint(tt.toFind, arr, cmp.Compare[int])
+			if idx != tt.wantLower || ok != found {
+				t.Errorf("InsertionPoint: got (%d, %t); want (%d, %t)", idx, ok, tt.wantLower, found)
+			}
+
+			wantFirst, wantLast := 0, 0
+			if found {
+				wantFirst, wantLast = tt.wantLower, tt.wantUpper-1
+			}
+			if idx, ok := FirstOccurrence(tt.toFind, arr, cmp.Compare[int]); idx != wantFirst || ok != found {
+				t.Errorf("FirstOccurrence: got (%d, %t); want (%d, %t)", idx, ok, wantFirst, found)
+			}
+			if idx, ok := LastOccurrence(tt.toFind, arr, cmp.Compare[int]); idx != wantLast || ok != found {
+				t.Errorf("LastOccurrence: got (%d, %t); want (%d, %t)", idx, ok, wantLast, found)
+			}
+		})
+	}
+}
+
+func TestInsertionPointMatchesSlices(t *testing.T) {
+	arr := []int{2, 2, 4, 4, 4, 6, 10, 10}
+
+	for needle := range 12 {
+		wantIdx, wantOk := slices.BinarySearchFunc(arr, needle, cmp.Compare[int])
+		gotIdx, gotOk := InsertionPoint(needle, arr, cmp.Compare[int])
+		if gotIdx != wantIdx || gotOk != wantOk {
+			t.Errorf("needle %d: got (%d, %t); want (%d, %t)", needle, gotIdx, gotOk, wantIdx, wantOk)
+		}
+	}
+}
+
+func TestBoundsEmptyArr(t *testing.T) {
+	if got := LowerBound(1, []int{}, cmp.Compare[int]); got != 0 {
+		t.Errorf("LowerBound: got %d; want 0", got)
+	}
+	if got := UpperBound(1, []int{}, cmp.Compare[int]); got != 0 {
+		t.Errorf("UpperBound: got %d; want 0", got)
+	}
+	if _, ok := LastOccurrence(1, []int{}, cmp.Compare[int]); ok {
+		t.Error("LastOccurrence: expected not found")
+	}
+}
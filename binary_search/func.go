@@ -0,0 +1,45 @@
+package binarysearch
+
+import "cmp"
+
+// SearchFunc returns the smallest index i in [0, n) for which pred(i) is
+// true, or n if there is none. pred must be false for some prefix of the
+// range and true for the rest. It never touches memory itself, so it works
+// on any indexable source.
+func SearchFunc(n int, pred func(i int) bool) int {
+	start, end := 0, n
+
+	for start < end {
+		mid := (end-start)/2 + start
+		if !pred(mid) {
+			start = mid + 1
+		} else {
+			end = mid
+		}
+	}
+	return start
+}
+
+// SearchAt binary searches n sorted elements read through at. Like
+// InsertionPoint it returns the index of the first element equal to needle,
+// or where needle would be inserted, and whether it was found.
+func SearchAt[T any](needle T, n int, at func(i int) T, comp func(T, T) int) (int, bool) {
+	i := SearchFunc(n, func(i int) bool {
+		return comp(at(i), needle) >= 0
+	})
+	return i, i < n && comp(at(i), needle) == 0
+}
+
+// SearchBy searches a slice sorted by keyOf for the first element whose key
+// equals key, without building a needle of type T. The index is the
+// insertion point when the key is not found.
+func SearchBy[S ~[]T, T any, K cmp.Ordered](key K, haystack S, keyOf func(T) K) (int, bool) {
+	return SearchByFunc(key, haystack, keyOf, cmp.Compare[K])
+}
+
+// SearchByFunc is SearchBy for keys that are compared with comp.
+func SearchByFunc[S ~[]T, T, K any](key K, haystack S, keyOf func(T) K, comp func(K, K) int) (int, bool) {
+	return SearchAt(key, len(haystack), func(i int) K {
+		return keyOf(haystack[i])
+	}, comp)
+}
@@ -0,0 +1,122 @@
+package binarysearch
+
+import (
+	"cmp"
+	"strings"
+	"testing"
+
+	"github.com/zukofett/go_algo/array"
+)
+
+func TestSearchFunc(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+		pred func(int) bool
+		want int
+	}{
+		{
+			name: "empty range",
+			n:    0,
+			pred: func(int) bool { return true },
+			want: 0,
+		}, {
+			name: "always true",
+			n:    10,
+			pred: func(int) bool { return true },
+			want: 0,
+		}, {
+			name: "never true",
+			n:    10,
+			pred: func(int) bool { return false },
+			want: 10,
+		}, {
+			name: "true from the middle",
+			n:    100,
+			pred: func(i int) bool { return i*i >= 2000 },
+			want: 45,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SearchFunc(tt.n, tt.pred); got != tt.want {
+				t.Errorf("got %d; want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchAtArray(t *testing.T) {
+	vals := []int{2, 4, 4, 8, 16, 32}
+	arr := array.NewArray[int](len(vals))
+	arr.CopyFrom(vals)
+
+	cases := []struct {
+		name    string
+		toFind  int
+		want    bool
+		wantIdx int
+	}{
+		{
+			name:    "duplicate returns first",
+			toFind:  4,
+			want:    true,
+			wantIdx: 1,
+		}, {
+			name:    "last element",
+			toFind:  32,
+			want:    true,
+			wantIdx: 5,
+		}, {
+			name:    "missing returns insertion point",
+			toFind:  10,
+			want:    false,
+			wantIdx: 4,
+		}, {
+			name:    "past the end",
+			toFind:  33,
+			want:    false,
+			wantIdx: 6,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIdx, got := SearchAt(tt.toFind, arr.Len(), arr.Get, cmp.Compare[int])
+			if got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+			if gotIdx != tt.wantIdx {
+				t.Errorf("got index %d; want index %d", gotIdx, tt.wantIdx)
+			}
+		})
+	}
+}
+
+func TestSearchBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+
+	users := []user{
+		{id: 3, name: "Ada"},
+		{id: 7, name: "bob"},
+		{id: 7, name: "Carol"},
+		{id: 12, name: "dave"},
+	}
+
+	if idx, ok := SearchBy(7, users, func(u user) int { return u.id }); !ok || idx != 1 {
+		t.Errorf("got (%d, %t); want (1, true)", idx, ok)
+	}
+
+	if idx, ok := SearchBy(8, users, func(u user) int { return u.id }); ok || idx != 3 {
+		t.Errorf("got (%d, %t); want (3, false)", idx, ok)
+	}
+
+	lower := func(u user) string { return strings.ToLower(u.name) }
+	if idx, ok := SearchByFunc("carol", users, lower, strings.Compare); !ok || idx != 2 {
+		t.Errorf("got (%d, %t); want (2, true)", idx, ok)
+	}
+}
@@ -0,0 +1,164 @@
+package binarysearch
+
+// Result is what the searches in this file return. Index is the position of
+// the match when Found is true and 0 otherwise, the same convention as
+// BinarySearch.
+type Result struct {
+	Index int
+	Found bool
+}
+
+// Number is the set of key types InterpolationSearch can do arithmetic on.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// ExponentialSearch gallops through haystack doubling the probed index until
+// it passes needle, then binary searches the last gap. It is faster than
+// BinarySearch when the match is near the start.
+func ExponentialSearch[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) Result {
+	return ExponentialSearchFunc(needle, func(i int) (T, bool) {
+		if i >= len(haystack) {
+			var noop T
+			return noop, false
+		}
+		return haystack[i], true
+	}, comp)
+}
+
+// ExponentialSearchFunc is ExponentialSearch over a sorted source of unknown
+// length. at returns the element at index i, or false once i is past the end.
+func ExponentialSearchFunc[T any](needle T, at func(i int) (T, bool), comp func(T, T) int) Result {
+	// probe compares the element at i with needle, treating indexes past the
+	// end as greater than everything.
+	probe := func(i int) int {
+		val, ok := at(i)
+		if !ok {
+			return 1
+		}
+		return comp(val, needle)
+	}
+
+	if _, ok := at(0); !ok {
+		return Result{}
+	}
+
+	bound := 1
+	for probe(bound) < 0 {
+		bound *= 2
+	}
+
+	start := bound / 2
+	end := bound
+
+	for start <= end {
+		mid := (end-start)/2 + start
+		res := probe(mid)
+		if res > 0 {
+			end = mid - 1
+		} else if res < 0 {
+			start = mid + 1
+		} else {
+			return Result{Index: mid, Found: true}
+		}
+	}
+	return Result{}
+}
+
+// InterpolationSearch guesses where needle sits by assuming the keys are
+// spread evenly between the first and last element. On uniformly distributed
+// keys it takes O(log log n) probes, degrading to O(n) on skewed data.
+func InterpolationSearch[S ~[]T, T Number](needle T, haystack S) Result {
+	start := 0
+	end := len(haystack) - 1
+
+	for start <= end && needle >= haystack[start] && needle <= haystack[end] {
+		lo, hi := haystack[start], haystack[end]
+		if lo == hi {
+			break
+		}
+
+		frac := (float64(needle) - float64(lo)) / (float64(hi) - float64(lo))
+		mid := start + int(frac*float64(end-start))
+		mid = min(max(mid, start), end)
+
+		if haystack[mid] < needle {
+			start = mid + 1
+		} else if haystack[mid] > needle {
+			end = mid - 1
+		} else {
+			return Result{Index: mid, Found: true}
+		}
+	}
+
+	if start <= end && haystack[start] == needle {
+		return Result{Index: start, Found: true}
+	}
+	return Result{}
+}
+
+// FibonacciSearch splits the haystack at Fibonacci numbers instead of
+// halving it, so it only needs addition and subtraction to find the probes.
+func FibonacciSearch[S ~[]T, T any](needle T, haystack S, comp func(T, T) int) Result {
+	fib2, fib1 := 0, 1
+	fib := fib1 + fib2
+	for fib < len(haystack) {
+		fib2, fib1 = fib1, fib
+		fib = fib1 + fib2
+	}
+
+	offset := -1
+	for fib > 1 {
+		i := min(offset+fib2, len(haystack)-1)
+		res := comp(haystack[i], needle)
+		if res < 0 {
+			fib, fib1 = fib1, fib2
+			fib2 = fib - fib1
+			offset = i
+		} else if res > 0 {
+			fib, fib1 = fib2, fib1-fib2
+			fib2 = fib - fib1
+		} else {
+			return Result{Index: i, Found: true}
+		}
+	}
+
+	if fib1 == 1 && offset+1 < len(haystack) && comp(haystack[offset+1], needle) == 0 {
+		return Result{Index: offset + 1, Found: true}
+	}
+	return Result{}
+}
+
+// TernarySearch finds the index in [lo, hi) at which the unimodal function f
+// peaks according to comp. Pass a reversed comp to find a minimum instead.
+// Found is false when the range is empty.
+func TernarySearch[T any](lo, hi int, f func(int) T, comp func(T, T) int) Result {
+	if lo >= hi {
+		return Result{}
+	}
+
+	start := lo
+	end := hi - 1
+
+	for end-start > 2 {
+		third := (end - start) / 3
+		m1 := start + third
+		m2 := end - third
+		if comp(f(m1), f(m2)) < 0 {
+			start = m1 + 1
+		} else {
+			end = m2
+		}
+	}
+
+	best := start
+	bestVal := f(start)
+	for i := start + 1; i <= end; i++ {
+		if val := f(i); comp(val, bestVal) > 0 {
+			best, bestVal = i, val
+		}
+	}
+	return Result{Index: best, Found: true}
+}
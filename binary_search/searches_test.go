@@ -0,0 +1,196 @@
+package binarysearch
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestSearchesInts(t *testing.T) {
+	arr := []int{1, 3, 4, 69, 71, 81, 90, 99, 420, 1337, 69420}
+	searches := []struct {
+		name   string
+		search func(int, []int) Result
+	}{
+		{
+			name: "exponential",
+			search: func(needle int, s []int) Result {
+				return ExponentialSearch(needle, s, cmp.Compare[int])
+			},
+		}, {
+			name: "interpolation",
+			search: func(needle int, s []int) Result {
+				return InterpolationSearch(needle, s)
+			},
+		}, {
+			name: "fibonacci",
+			search: func(needle int, s []int) Result {
+				return FibonacciSearch(needle, s, cmp.Compare[int])
+			},
+		},
+	}
+
+	for _, search := range searches {
+		for needle := -1; needle <= 70000; needle++ {
+			if needle > 1400 && needle < 69000 {
+				continue
+			}
+
+			want := Result{}
+			if idx, ok := slices.BinarySearch(arr, needle); ok {
+				want = Result{Index: idx, Found: true}
+			}
+
+			if got := search.search(needle, arr); got != want {
+				t.Fatalf("%s search for %d: got %+v; want %+v", search.name, needle, got, want)
+			}
+		}
+
+		if got := search.search(1, []int{}); got.Found {
+			t.Errorf("%s search on empty arr: got %+v; want not found", search.name, got)
+		}
+	}
+}
+
+func TestSearchesRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	for n := range 40 {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = rng.IntN(3 * n)
+		}
+		slices.Sort(arr)
+		arr = slices.Compact(arr)
+
+		for needle := -1; needle <= 3*n; needle++ {
+			_, want := slices.BinarySearch(arr, needle)
+
+			for name, got := range map[string]Result{
+				"exponential":   ExponentialSearch(needle, arr, cmp.Compare[int]),
+				"interpolation": InterpolationSearch(needle, arr),
+				"fibonacci":     FibonacciSearch(needle, arr, cmp.Compare[int]),
+			} {
+				if got.Found != want || (want && arr[got.Index] != needle) {
+					t.Fatalf("%s search for %d in %v: got %+v; want found %t", name, needle, arr, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestExponentialSearchFunc(t *testing.T) {
+	// Squares of every natural number, with no known length.
+	at := func(i int) (int, bool) {
+		return i * i, true
+	}
+
+	if got := ExponentialSearchFunc(1024*1024, at, cmp.Compare[int]); !got.Found || got.Index != 1024 {
+		t.Errorf("got %+v; want index 1024", got)
+	}
+
+	if got := ExponentialSearchFunc(1024*1024+1, at, cmp.Compare[int]); got.Found {
+		t.Errorf("got %+v; want not found", got)
+	}
+}
+
+func TestInterpolationSearchFloats(t *testing.T) {
+	arr := []float64{0.5, 0.5, 1.25, 2, 2, 2, 3.75}
+
+	for _, needle := range arr {
+		if got := InterpolationSearch(needle, arr); !got.Found || arr[got.Index] != needle {
+			t.Errorf("search for %v: got %+v", needle, got)
+		}
+	}
+
+	if got := InterpolationSearch(1.5, arr); got.Found {
+		t.Errorf("got %+v; want not found", got)
+	}
+}
+
+func TestTernarySearch(t *testing.T) {
+	cases := []struct {
+		name   string
+		lo, hi int
+		f      func(int) int
+		comp   func(int, int) int
+		want   Result
+	}{
+		{
+			name: "peak in the middle",
+			lo:   0,
+			hi:   100,
+			f:    func(i int) int { return -(i - 37) * (i - 37) },
+			comp: cmp.Compare[int],
+			want: Result{Index: 37, Found: true},
+		}, {
+			name: "minimum with reversed comp",
+			lo:   -50,
+			hi:   50,
+			f:    func(i int) int { return (i + 12) * (i + 12) },
+			comp: func(a, b int) int { return cmp.Compare(b, a) },
+			want: Result{Index: -12, Found: true},
+		}, {
+			name: "increasing function",
+			lo:   0,
+			hi:   10,
+			f:    func(i int) int { return i },
+			comp: cmp.Compare[int],
+			want: Result{Index: 9, Found: true},
+		}, {
+			name: "single element",
+			lo:   5,
+			hi:   6,
+			f:    func(i int) int { return i },
+			comp: cmp.Compare[int],
+			want: Result{Index: 5, Found: true},
+		}, {
+			name: "empty range",
+			lo:   5,
+			hi:   5,
+			f:    func(i int) int { return i },
+			comp: cmp.Compare[int],
+			want: Result{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TernarySearch(tt.lo, tt.hi, tt.f, tt.comp); got != tt.want {
+				t.Errorf("got %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSearches(b *testing.B) {
+	for _, size := range []int{1 << 10, 1 << 20} {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = 3 * i
+		}
+
+		b.Run(fmt.Sprintf("binary/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				BinarySearch(arr[i%size], arr, cmp.Compare[int])
+			}
+		})
+		b.Run(fmt.Sprintf("exponential/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				ExponentialSearch(arr[i%size], arr, cmp.Compare[int])
+			}
+		})
+		b.Run(fmt.Sprintf("interpolation/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				InterpolationSearch(arr[i%size], arr)
+			}
+		})
+		b.Run(fmt.Sprintf("fibonacci/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				FibonacciSearch(arr[i%size], arr, cmp.Compare[int])
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package bubblesort
 
-func BubbleSort[S ~[]T, T comparable](s S, comp func(T, T) int) {
+// BubbleSort sorts s in place by swapping adjacent elements that are out of
+// order. It only swaps when comp reports a strict inversion, so equal
+// elements never pass each other and the sort is stable.
+func BubbleSort[S ~[]T, T any](s S, comp func(T, T) int) {
 	for i := range s {
 		changed := false
 		for j := 0; j < len(s)-1-i; j++ {
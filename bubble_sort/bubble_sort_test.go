@@ -78,3 +78,26 @@ func TestBubbleSortStrings(t *testing.T) {
 		})
 	}
 }
+
+func TestBubbleSortNonComparable(t *testing.T) {
+	type record struct {
+		key    int
+		values []string
+	}
+
+	arr := []record{
+		{key: 3, values: []string{"c"}},
+		{key: 1, values: []string{"a"}},
+		{key: 2, values: nil},
+	}
+
+	BubbleSort(arr, func(a, b record) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	for i, rec := range arr {
+		if rec.key != i+1 {
+			t.Fatalf("expected key %d at index %d but got: %+v", i+1, i, arr)
+		}
+	}
+}
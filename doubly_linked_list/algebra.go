@@ -0,0 +1,120 @@
+package doublylinkedlist
+
+// Reverse reverses the order of the list in place by swapping the links of
+// every node.
+func (l *DoublyLinkedList[T]) Reverse() {
+	if l == nil || l.length < 2 {
+		return
+	}
+
+	first, last := l.head.next, l.tail.prev
+	for n := first; n != &l.tail; {
+		next := n.next
+		n.next, n.prev = n.prev, n.next
+		n = next
+	}
+
+	l.head.next, last.prev = last, &l.head
+	l.tail.prev, first.next = first, &l.tail
+}
+
+// MergeSorted merges other into l. Both lists must already be sorted by comp.
+// The nodes of other are moved rather than copied, so other is left empty
+// and its *DLLNode handles now belong to l. The merge is stable, with
+// elements of l ahead of equal elements of other, and nil Data sorts last
+// like Sort.
+func (l *DoublyLinkedList[T]) MergeSorted(other *DoublyLinkedList[T], comp func(*T, *T) int) {
+	if l == nil || other == nil || l == other || other.length == 0 {
+		return
+	}
+
+	for n := range other.Nodes() {
+		n.list = l
+	}
+
+	first, _ := mergeNodes(l.detach(), other.detach(), comp)
+	l.relink(first)
+	l.length += other.length
+
+	other.head.next = &other.tail
+	other.tail.prev = &other.head
+	other.length = 0
+}
+
+// detach cuts the sentinels off the list and returns its nodes as a
+// nil-terminated chain. The list must be relinked before it is used again.
+func (l *DoublyLinkedList[T]) detach() *DLLNode[T] {
+	if l.length == 0 {
+		return nil
+	}
+
+	l.tail.prev.next = nil
+	return l.head.next
+}
+
+// relink makes the nil-terminated chain starting at first the contents of the
+// list, setting every prev pointer along the way.
+func (l *DoublyLinkedList[T]) relink(first *DLLNode[T]) {
+	prev := &l.head
+	for n := first; n != nil; n = n.next {
+		prev.next = n
+		n.prev = prev
+		prev = n
+	}
+	prev.next = &l.tail
+	l.tail.prev = prev
+}
+
+// Unique removes every node whose Data compares equal to the node before it,
+// so runs of equal elements are reduced to their first node. Two nil Data
+// count as equal. It returns the number of nodes removed.
+func (l *DoublyLinkedList[T]) Unique(comp func(*T, *T) int) int {
+	if l == nil || l.length < 2 {
+		return 0
+	}
+
+	removed := 0
+	for n := l.head.next.next; n != &l.tail; {
+		next := n.next
+		if equal(n.prev.Data, n.Data, comp) {
+			l.Remove(n)
+			removed++
+		}
+		n = next
+	}
+	return removed
+}
+
+func equal[T any](a, b *T, comp func(*T, *T) int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return comp(a, b) == 0
+}
+
+// RemoveIf removes every node for which pred returns true and returns how
+// many were removed. Like ForEach, it skips nodes whose Data is nil.
+func (l *DoublyLinkedList[T]) RemoveIf(pred func(*T) bool) int {
+	if l == nil {
+		return 0
+	}
+
+	removed := 0
+	for n := l.head.next; n != &l.tail; {
+		next := n.next
+		if n.Data != nil && pred(n.Data) {
+			l.Remove(n)
+			removed++
+		}
+		n = next
+	}
+	return removed
+}
+
+// Filter keeps only the nodes for which keep returns true and returns how
+// many were removed. Nodes whose Data is nil are kept.
+func (l *DoublyLinkedList[T]) Filter(keep func(*T) bool) int {
+	return l.RemoveIf(func(val *T) bool {
+		return !keep(val)
+	})
+}
@@ -0,0 +1,234 @@
+package doublylinkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+// checkList fails the test if the list does not pass Validate.
+func checkList[T any](t *testing.T, l *DoublyLinkedList[T]) {
+	t.Helper()
+
+	if err := l.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDListReverse(t *testing.T) {
+	cases := []struct {
+		name string
+		init []int
+	}{
+		{name: "empty list", init: []int{}},
+		{name: "one element", init: []int{1}},
+		{name: "two elements", init: []int{1, 2}},
+		{name: "few elements", init: []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice(tt.init)
+			nodes := slices.Collect(list.Nodes())
+
+			list.Reverse()
+			checkList(t, list)
+
+			want := slices.Clone(tt.init)
+			slices.Reverse(want)
+			if got := list.ToSlice(); !slices.Equal(got, want) {
+				t.Errorf("got %v; want %v", got, want)
+			}
+
+			slices.Reverse(nodes)
+			if got := slices.Collect(list.Nodes()); !slices.Equal(got, nodes) {
+				t.Error("nodes were not relinked in reverse order")
+			}
+		})
+	}
+}
+
+func TestDListMergeSorted(t *testing.T) {
+	cases := []struct {
+		name        string
+		left, right []int
+	}{
+		{name: "both empty", left: []int{}, right: []int{}},
+		{name: "left empty", left: []int{}, right: []int{1, 2}},
+		{name: "right empty", left: []int{1, 2}, right: []int{}},
+		{name: "interleaved", left: []int{1, 3, 5}, right: []int{2, 4, 6, 8}},
+		{name: "duplicates", left: []int{1, 2, 2, 3}, right: []int{2, 3, 3}},
+		{name: "right before left", left: []int{5, 6}, right: []int{1, 2}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			left := createDListFromSlice(tt.left)
+			right := createDListFromSlice(tt.right)
+			moved := slices.Collect(right.Nodes())
+
+			left.MergeSorted(right, compInts)
+			checkList(t, left)
+			checkList(t, right)
+
+			want := slices.Sorted(slices.Values(append(slices.Clone(tt.left), tt.right...)))
+			if got := left.ToSlice(); !slices.Equal(got, want) {
+				t.Errorf("got %v; want %v", got, want)
+			}
+			if !right.IsEmpty() {
+				t.Errorf("got other of len %d; want empty", right.Len())
+			}
+
+			nodes := slices.Collect(left.Nodes())
+			for _, n := range moved {
+				if !slices.Contains(nodes, n) {
+					t.Fatalf("node %p of other was not moved", n)
+				}
+			}
+
+			val := 7
+			right.Insert(right.End(), &val)
+			if got := right.ToSlice(); !slices.Equal(got, []int{7}) {
+				t.Errorf("got other %v after reuse; want [7]", got)
+			}
+		})
+	}
+}
+
+func TestDListMergeSortedStable(t *testing.T) {
+	type record struct {
+		key  int
+		from string
+	}
+	compRecords := func(a, b *record) int {
+		return cmp.Compare(a.key, b.key)
+	}
+
+	left := createDListFromSlice([]record{{1, "l"}, {2, "l"}})
+	right := createDListFromSlice([]record{{1, "r"}, {2, "r"}})
+	left.MergeSorted(right, compRecords)
+
+	want := []record{{1, "l"}, {1, "r"}, {2, "l"}, {2, "r"}}
+	if got := left.ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestDListMergeSortedEdge(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2})
+	list.MergeSorted(list, compInts)
+	list.MergeSorted(nil, compInts)
+	checkList(t, list)
+
+	if got := list.ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("got %v; want [1 2]", got)
+	}
+
+	var nilList *DoublyLinkedList[int]
+	nilList.MergeSorted(list, compInts)
+}
+
+func TestDListUnique(t *testing.T) {
+	cases := []struct {
+		name        string
+		init        []int
+		want        []int
+		wantRemoved int
+	}{
+		{name: "empty list", init: []int{}, want: []int{}, wantRemoved: 0},
+		{name: "one element", init: []int{1}, want: []int{1}, wantRemoved: 0},
+		{name: "all equal", init: []int{4, 4, 4}, want: []int{4}, wantRemoved: 2},
+		{name: "runs", init: []int{1, 1, 2, 2, 2, 3, 1, 1}, want: []int{1, 2, 3, 1}, wantRemoved: 4},
+		{name: "no duplicates", init: []int{1, 2, 1}, want: []int{1, 2, 1}, wantRemoved: 0},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice(tt.init)
+			first := list.Begin()
+
+			if got := list.Unique(compInts); got != tt.wantRemoved {
+				t.Errorf("got %d removed; want %d", got, tt.wantRemoved)
+			}
+			checkList(t, list)
+
+			if got := list.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+			if len(tt.init) > 0 && list.Begin() != first {
+				t.Error("the first node of a run should be kept")
+			}
+		})
+	}
+}
+
+func TestDListUniqueNilData(t *testing.T) {
+	list := createDListFromSlice([]int{1})
+	list.Insert(list.End(), nil)
+	list.Insert(list.End(), nil)
+
+	if got := list.Unique(compInts); got != 1 {
+		t.Errorf("got %d removed; want 1", got)
+	}
+	checkList(t, list)
+}
+
+func TestDListRemoveIf(t *testing.T) {
+	isEven := func(v *int) bool { return *v%2 == 0 }
+
+	cases := []struct {
+		name string
+		init []int
+		want []int
+	}{
+		{name: "empty list", init: []int{}, want: []int{}},
+		{name: "remove none", init: []int{1, 3, 5}, want: []int{1, 3, 5}},
+		{name: "remove all", init: []int{2, 4, 6}, want: []int{}},
+		{name: "remove head and tail", init: []int{2, 1, 3, 4}, want: []int{1, 3}},
+		{name: "remove alternating", init: []int{1, 2, 3, 4, 5, 6}, want: []int{1, 3, 5}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice(tt.init)
+
+			if got, want := list.RemoveIf(isEven), len(tt.init)-len(tt.want); got != want {
+				t.Errorf("got %d removed; want %d", got, want)
+			}
+			checkList(t, list)
+
+			if got := list.ToSlice(); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDListFilter(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2, 3, 4, 5, 6})
+	list.Insert(list.Begin(), nil)
+
+	if got := list.Filter(func(v *int) bool { return *v > 3 }); got != 3 {
+		t.Errorf("got %d removed; want 3", got)
+	}
+	checkList(t, list)
+
+	if got := list.ToSlice(); !slices.Equal(got, []int{4, 5, 6}) {
+		t.Errorf("got %v; want [4 5 6]", got)
+	}
+	if list.Begin().Data != nil {
+		t.Error("nodes with nil data should be kept")
+	}
+}
+
+func TestDListAlgebraEdge(t *testing.T) {
+	var list *DoublyLinkedList[int]
+
+	list.Reverse()
+	if got := list.Unique(compInts); got != 0 {
+		t.Errorf("got %d removed; want 0", got)
+	}
+	if got := list.RemoveIf(func(*int) bool { return true }); got != 0 {
+		t.Errorf("got %d removed; want 0", got)
+	}
+}
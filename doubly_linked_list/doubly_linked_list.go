@@ -1,8 +1,23 @@
 package doublylinkedlist
 
+import (
+	"errors"
+	"iter"
+)
+
+var (
+	ErrForeignNode  = errors.New("doublylinkedlist: node belongs to another list")
+	ErrRemovedNode  = errors.New("doublylinkedlist: node is not in a list")
+	ErrSentinelNode = errors.New("doublylinkedlist: node is a sentinel")
+)
+
+// DLLNode is an element of a DoublyLinkedList. It remembers the list it
+// belongs to, so nodes from another list or nodes that have been removed are
+// rejected instead of corrupting the list.
 type DLLNode[T any] struct {
 	Data       *T
 	next, prev *DLLNode[T]
+	list       *DoublyLinkedList[T]
 }
 
 func (n *DLLNode[T]) Next() *DLLNode[T] {
@@ -19,6 +34,13 @@ func (n *DLLNode[T]) Prev() *DLLNode[T] {
 	return n.prev
 }
 
+// DoublyLinkedList keeps its nodes between a head and a tail sentinel, so
+// inserting or removing at either end needs no special case.
+//
+// Insert, PushBack and PushFront take a *T and allow nil, which marks a node
+// without a value; ToSlice, All and Find skip those nodes, and PopFront and
+// PopBack return nil for them. The value methods copy elements in and out
+// instead: they never store nil and read a nil Data as the zero value of T.
 type DoublyLinkedList[T any] struct {
 	head, tail DLLNode[T]
 	length     int
@@ -28,6 +50,8 @@ func NewDLL[T any]() *DoublyLinkedList[T] {
 	newDLL := DoublyLinkedList[T]{}
 	newDLL.head.next = &newDLL.tail
 	newDLL.tail.prev = &newDLL.head
+	newDLL.head.list = &newDLL
+	newDLL.tail.list = &newDLL
 
 	return &newDLL
 }
@@ -58,26 +82,47 @@ func (l *DoublyLinkedList[T]) End() *DLLNode[T] {
 }
 
 func (l *DoublyLinkedList[T]) Insert(at *DLLNode[T], val *T) *DLLNode[T] {
-	if l == nil || at == nil {
-		return nil
+	n, _ := l.InsertChecked(at, val)
+	return n
+}
+
+// InsertChecked is Insert, but reports why nothing was inserted: at is nil,
+// removed, belongs to another list or is the head sentinel.
+func (l *DoublyLinkedList[T]) InsertChecked(at *DLLNode[T], val *T) (*DLLNode[T], error) {
+	if err := l.checkNode(at); err != nil {
+		return nil, err
+	}
+	if at == &l.head {
+		return nil, ErrSentinelNode
 	}
 
 	newNode := &DLLNode[T]{
 		Data: val,
 		next: at,
 		prev: at.prev,
+		list: l,
 	}
 
 	at.prev.next = newNode
 	at.prev = newNode
 	l.length++
 
-	return newNode
+	return newNode, nil
 }
 
 func (l *DoublyLinkedList[T]) Remove(at *DLLNode[T]) *DLLNode[T] {
-	if l == nil || at == nil || at == &l.head || at == &l.tail {
-		return nil
+	n, _ := l.RemoveChecked(at)
+	return n
+}
+
+// RemoveChecked is Remove, but reports why nothing was removed: at is nil,
+// already removed, belongs to another list or is a sentinel.
+func (l *DoublyLinkedList[T]) RemoveChecked(at *DLLNode[T]) (*DLLNode[T], error) {
+	if err := l.checkNode(at); err != nil {
+		return nil, err
+	}
+	if at == &l.head || at == &l.tail {
+		return nil, ErrSentinelNode
 	}
 
 	next := at.next
@@ -89,16 +134,34 @@ func (l *DoublyLinkedList[T]) Remove(at *DLLNode[T]) *DLLNode[T] {
 	at.next = nil
 	at.prev = nil
 	at.Data = nil
+	at.list = nil
+
+	return next, nil
+}
 
-	return next
+// checkNode returns an error unless n is a node of l.
+func (l *DoublyLinkedList[T]) checkNode(n *DLLNode[T]) error {
+	switch {
+	case n == nil || n.list == nil:
+		return ErrRemovedNode
+	case n.list != l:
+		return ErrForeignNode
+	}
+	return nil
 }
 
+// Find returns the first node in [from, to) whose Data compares equal to
+// data, or to if there is none. It returns nil if from or to is not a node
+// of l, or if to does not come after from.
 func (l *DoublyLinkedList[T]) Find(from, to *DLLNode[T], data *T, comp func(*T, *T) int) *DLLNode[T] {
-	if l == nil || from == nil || to == nil {
+	if l.checkNode(from) != nil || l.checkNode(to) != nil {
 		return nil
 	}
 
 	for from != to {
+		if from == &l.tail {
+			return nil
+		}
 		if from.Data != nil && comp(from.Data, data) == 0 {
 			return from
 		}
@@ -107,12 +170,18 @@ func (l *DoublyLinkedList[T]) Find(from, to *DLLNode[T], data *T, comp func(*T,
 	return from
 }
 
+// ForEach calls do on the Data of every node in [from, to) until do returns
+// false, and returns the node it stopped at. Like Find, it returns nil if
+// from and to do not delimit a range of l.
 func (l *DoublyLinkedList[T]) ForEach(from, to *DLLNode[T], do func(*T) bool) *DLLNode[T] {
-	if l == nil || from == nil || to == nil {
+	if l.checkNode(from) != nil || l.checkNode(to) != nil {
 		return nil
 	}
 
 	for from != to {
+		if from == &l.tail {
+			return nil
+		}
 		if from.Data != nil && !do(from.Data) {
 			return from
 		}
@@ -163,12 +232,42 @@ func (l *DoublyLinkedList[T]) PopBack() *T {
 	return val
 }
 
+// Splice moves the nodes in [begin, end) of l to just before at. It is
+// SpliceFrom with l as the source, so use SpliceFrom to move nodes between
+// lists.
 func (l *DoublyLinkedList[T]) Splice(at, begin, end *DLLNode[T]) *DLLNode[T] {
-	if l == nil || at == nil || begin == nil || end == nil {
+	return l.SpliceFrom(at, l, begin, end)
+}
+
+// SpliceFrom moves the nodes in [begin, end) of src to just before at in l
+// and updates the length of both lists. The nodes are relinked, not copied,
+// so their handles stay valid. Passing n and n.Next() moves a single node and
+// src.Begin() and src.End() move the whole list.
+//
+// It returns the last node moved, or nil if nothing was moved: when at is not
+// a node of l, when the range is empty or not a range of src, or when src is
+// l and at lies inside the range. Counting the range takes time proportional
+// to its length.
+func (l *DoublyLinkedList[T]) SpliceFrom(at *DLLNode[T], src *DoublyLinkedList[T], begin, end *DLLNode[T]) *DLLNode[T] {
+	if l == nil || src == nil || at == nil || begin == nil || end == nil {
+		return nil
+	}
+	if l.checkNode(at) != nil || at == &l.head || begin == &src.head {
 		return nil
 	}
 
-	last := end.Prev()
+	count := 0
+	for n := begin; n != end; n = n.next {
+		if n == nil || n.list != src || n == &src.tail || (src == l && n == at) {
+			return nil
+		}
+		count++
+	}
+	if count == 0 {
+		return nil
+	}
+
+	last := end.prev
 
 	begin.prev.next = end
 	end.prev = begin.prev
@@ -179,15 +278,25 @@ func (l *DoublyLinkedList[T]) Splice(at, begin, end *DLLNode[T]) *DLLNode[T] {
 	last.next = at
 	at.prev = last
 
+	for n := begin; src != l && n != at; n = n.next {
+		n.list = l
+	}
+	src.length -= count
+	l.length += count
 	return last
 }
 
+// MultiFind appends the Data of every node in [from, to) that compares equal
+// to data to ret. It does nothing if from and to do not delimit a range of l.
 func (l *DoublyLinkedList[T]) MultiFind(from, to *DLLNode[T], data *T, comp func(*T, *T) int, ret *DoublyLinkedList[T]) {
-	if l == nil || from == nil || to == nil || ret == nil {
+	if l.checkNode(from) != nil || l.checkNode(to) != nil || ret == nil {
 		return
 	}
 
 	for from != to {
+		if from == &l.tail {
+			return
+		}
 		if from.Data != nil && comp(from.Data, data) == 0 {
 			ret.PushBack(from.Data)
 		}
@@ -209,3 +318,67 @@ func (l *DoublyLinkedList[T]) ToSlice() []T {
 	})
 	return s
 }
+
+// Nodes yields every node between Begin and End, including nodes whose Data
+// is nil.
+func (l *DoublyLinkedList[T]) Nodes() iter.Seq[*DLLNode[T]] {
+	return func(yield func(*DLLNode[T]) bool) {
+		if l == nil {
+			return
+		}
+		for n := l.Begin(); n != l.End(); n = n.next {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardNodes yields every node between End and Begin in reverse order.
+func (l *DoublyLinkedList[T]) BackwardNodes() iter.Seq[*DLLNode[T]] {
+	return func(yield func(*DLLNode[T]) bool) {
+		if l == nil {
+			return
+		}
+		for n := l.End().prev; n != &l.head; n = n.prev {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// All yields the values of the list in order, skipping nil Data like ToSlice.
+func (l *DoublyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range l.Nodes() {
+			if n.Data != nil && !yield(*n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields the values of the list from the back to the front.
+func (l *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range l.BackwardNodes() {
+			if n.Data != nil && !yield(*n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed yields the values of the list with their index in ToSlice.
+func (l *DoublyLinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for val := range l.All() {
+			if !yield(i, val) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package doublylinkedlist
+
+// Sort sorts the list in place with a bottom-up merge sort that relinks the
+// existing nodes, so every *DLLNode keeps its Data and stays in the list. It
+// is stable, runs in O(n log n) and uses O(1) extra memory. Nodes whose Data
+// is nil are moved to the back and comp is never called with nil.
+//
+// The merge passes only maintain next pointers; prev pointers are restored in
+// one final walk.
+func (l *DoublyLinkedList[T]) Sort(comp func(*T, *T) int) {
+	if l == nil || l.length < 2 {
+		return
+	}
+
+	first := l.detach()
+	for width := 1; width < l.length; width *= 2 {
+		var dummy DLLNode[T]
+		end := &dummy
+		for rest := first; rest != nil; {
+			left := rest
+			right := splitAfter(left, width)
+			rest = splitAfter(right, width)
+			end.next, end = mergeNodes(left, right, comp)
+		}
+		first = dummy.next
+	}
+	l.relink(first)
+}
+
+// splitAfter cuts the chain starting at n after its first k nodes and returns
+// the rest, which is nil if the chain was not longer than k.
+func splitAfter[T any](n *DLLNode[T], k int) *DLLNode[T] {
+	for ; n != nil && k > 1; k-- {
+		n = n.next
+	}
+	if n == nil {
+		return nil
+	}
+
+	rest := n.next
+	n.next = nil
+	return rest
+}
+
+// mergeNodes merges two sorted nil-terminated chains and returns the first
+// and last node of the result. Ties go to a, which keeps the merge stable.
+func mergeNodes[T any](a, b *DLLNode[T], comp func(*T, *T) int) (*DLLNode[T], *DLLNode[T]) {
+	var dummy DLLNode[T]
+	end := &dummy
+	for a != nil && b != nil {
+		if before(b, a, comp) {
+			end.next, b = b, b.next
+		} else {
+			end.next, a = a, a.next
+		}
+		end = end.next
+	}
+
+	if a == nil {
+		a = b
+	}
+	for end.next = a; end.next != nil; end = end.next {
+	}
+	return dummy.next, end
+}
+
+// before reports whether a sorts strictly before b, with nil Data last.
+func before[T any](a, b *DLLNode[T], comp func(*T, *T) int) bool {
+	switch {
+	case a.Data == nil:
+		return false
+	case b.Data == nil:
+		return true
+	default:
+		return comp(a.Data, b.Data) < 0
+	}
+}
@@ -0,0 +1,176 @@
+package doublylinkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// nodeAt returns the i-th node of l, or End if i is Len.
+func nodeAt[T any](l *DoublyLinkedList[T], i int) *DLLNode[T] {
+	n := l.Begin()
+	for ; i > 0; i-- {
+		n = n.Next()
+	}
+	return n
+}
+
+func TestDListSplice(t *testing.T) {
+	cases := []struct {
+		name       string
+		init       []int
+		at         int
+		begin, end int
+		want       []int
+	}{
+		{name: "move range back", init: []int{1, 2, 3, 4, 5}, at: 4, begin: 1, end: 3, want: []int{1, 4, 2, 3, 5}},
+		{name: "move range front", init: []int{1, 2, 3, 4, 5}, at: 0, begin: 3, end: 5, want: []int{4, 5, 1, 2, 3}},
+		{name: "move single node to end", init: []int{1, 2, 3}, at: 3, begin: 0, end: 1, want: []int{2, 3, 1}},
+		{name: "at range end", init: []int{1, 2, 3}, at: 2, begin: 0, end: 2, want: []int{1, 2, 3}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice(tt.init)
+			last := nodeAt(list, tt.end-1)
+
+			got := list.Splice(nodeAt(list, tt.at), nodeAt(list, tt.begin), nodeAt(list, tt.end))
+			if got != last {
+				t.Errorf("got node %p; want the last moved node %p", got, last)
+			}
+			checkList(t, list)
+
+			if vals := list.ToSlice(); !slices.Equal(vals, tt.want) {
+				t.Errorf("got %v; want %v", vals, tt.want)
+			}
+		})
+	}
+}
+
+func TestDListSpliceFrom(t *testing.T) {
+	cases := []struct {
+		name       string
+		dst, src   []int
+		at         int
+		begin, end int
+		wantDst    []int
+		wantSrc    []int
+	}{
+		{
+			name: "range into middle",
+			dst:  []int{1, 2, 3}, src: []int{7, 8, 9, 10},
+			at: 1, begin: 1, end: 3,
+			wantDst: []int{1, 8, 9, 2, 3}, wantSrc: []int{7, 10},
+		}, {
+			name: "single node",
+			dst:  []int{1, 2}, src: []int{7, 8, 9},
+			at: 2, begin: 2, end: 3,
+			wantDst: []int{1, 2, 9}, wantSrc: []int{7, 8},
+		}, {
+			name: "whole list",
+			dst:  []int{1, 2}, src: []int{7, 8, 9},
+			at: 0, begin: 0, end: 3,
+			wantDst: []int{7, 8, 9, 1, 2}, wantSrc: []int{},
+		}, {
+			name: "into empty list",
+			dst:  []int{}, src: []int{7, 8},
+			at: 0, begin: 0, end: 2,
+			wantDst: []int{7, 8}, wantSrc: []int{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := createDListFromSlice(tt.dst)
+			src := createDListFromSlice(tt.src)
+			begin := nodeAt(src, tt.begin)
+
+			if got := dst.SpliceFrom(nodeAt(dst, tt.at), src, begin, nodeAt(src, tt.end)); got == nil {
+				t.Fatal("want last moved node; got nil")
+			}
+			checkList(t, dst)
+			checkList(t, src)
+
+			if got := dst.ToSlice(); !slices.Equal(got, tt.wantDst) {
+				t.Errorf("got dst %v; want %v", got, tt.wantDst)
+			}
+			if got := src.ToSlice(); !slices.Equal(got, tt.wantSrc) {
+				t.Errorf("got src %v; want %v", got, tt.wantSrc)
+			}
+			if !slices.Contains(slices.Collect(dst.Nodes()), begin) {
+				t.Error("moved node is no longer reachable through its handle")
+			}
+		})
+	}
+}
+
+func TestDListSpliceFromRejected(t *testing.T) {
+	cases := []struct {
+		name string
+		do   func(l, other *DoublyLinkedList[int]) *DLLNode[int]
+	}{
+		{
+			name: "at inside range",
+			do: func(l, _ *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.Splice(nodeAt(l, 1), nodeAt(l, 0), nodeAt(l, 3))
+			},
+		}, {
+			name: "at is begin",
+			do: func(l, _ *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.Splice(nodeAt(l, 0), nodeAt(l, 0), nodeAt(l, 2))
+			},
+		}, {
+			name: "empty range",
+			do: func(l, other *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.SpliceFrom(l.End(), other, nodeAt(other, 1), nodeAt(other, 1))
+			},
+		}, {
+			name: "end before begin",
+			do: func(l, other *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.SpliceFrom(l.End(), other, nodeAt(other, 2), nodeAt(other, 0))
+			},
+		}, {
+			name: "range from another list",
+			do: func(l, other *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.SpliceFrom(l.End(), other, nodeAt(l, 0), nodeAt(l, 2))
+			},
+		}, {
+			name: "at from another list",
+			do: func(l, other *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.SpliceFrom(other.End(), other, nodeAt(other, 0), nodeAt(other, 1))
+			},
+		}, {
+			name: "removed node",
+			do: func(l, other *DoublyLinkedList[int]) *DLLNode[int] {
+				n := nodeAt(other, 0)
+				other.Remove(n)
+				return l.SpliceFrom(l.End(), other, n, other.End())
+			},
+		}, {
+			name: "nil source",
+			do: func(l, _ *DoublyLinkedList[int]) *DLLNode[int] {
+				return l.SpliceFrom(l.End(), nil, nodeAt(l, 0), nodeAt(l, 1))
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			l := createDListFromSlice([]int{1, 2, 3, 4})
+			other := createDListFromSlice([]int{7, 8, 9})
+			wantOther := other.Len()
+
+			if got := tt.do(l, other); got != nil {
+				t.Errorf("got node %p; want nil", got)
+			}
+			checkList(t, l)
+			checkList(t, other)
+
+			if got := l.ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+				t.Errorf("list changed to %v", got)
+			}
+			if other.Len() > wantOther {
+				t.Errorf("source grew to %d", other.Len())
+			}
+		})
+	}
+}
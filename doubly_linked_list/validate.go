@@ -0,0 +1,50 @@
+package doublylinkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrCorruptList = errors.New("doublylinkedlist: corrupt list")
+
+// Validate walks the list and checks that every next pointer is mirrored by
+// the prev pointer of the node it points to, that the walk ends at the tail
+// sentinel after exactly Len nodes and that every node belongs to l. It
+// returns an error wrapping ErrCorruptList that describes the first problem
+// found. It is meant for debugging and tests.
+func (l *DoublyLinkedList[T]) Validate() error {
+	if l == nil {
+		return nil
+	}
+	if l.head.prev != nil || l.tail.next != nil {
+		return fmt.Errorf("%w: sentinels are linked outside the list", ErrCorruptList)
+	}
+	if l.head.list != l || l.tail.list != l {
+		return fmt.Errorf("%w: sentinels belong to another list", ErrCorruptList)
+	}
+
+	count := 0
+	prev := &l.head
+	for n := l.head.next; n != &l.tail; n = n.next {
+		switch {
+		case n == nil:
+			return fmt.Errorf("%w: tail is not reachable after %d nodes", ErrCorruptList, count)
+		case n.prev != prev:
+			return fmt.Errorf("%w: node %d does not point back at its predecessor", ErrCorruptList, count)
+		case n.list != l:
+			return fmt.Errorf("%w: node %d belongs to another list", ErrCorruptList, count)
+		case count == l.length:
+			return fmt.Errorf("%w: more than %d nodes", ErrCorruptList, l.length)
+		}
+		prev = n
+		count++
+	}
+
+	if l.tail.prev != prev {
+		return fmt.Errorf("%w: tail does not point back at the last node", ErrCorruptList)
+	}
+	if count != l.length {
+		return fmt.Errorf("%w: found %d nodes, want %d", ErrCorruptList, count, l.length)
+	}
+	return nil
+}
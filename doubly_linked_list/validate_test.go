@@ -0,0 +1,178 @@
+package doublylinkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDListOwnership(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2, 3})
+	other := createDListFromSlice([]int{7, 8})
+	val := 5
+
+	removed := list.Begin()
+	list.Remove(removed)
+
+	cases := []struct {
+		name string
+		at   *DLLNode[int]
+		want error
+	}{
+		{name: "nil node", at: nil, want: ErrRemovedNode},
+		{name: "node of another list", at: other.Begin(), want: ErrForeignNode},
+		{name: "end of another list", at: other.End(), want: ErrForeignNode},
+		{name: "removed node", at: removed, want: ErrRemovedNode},
+		{name: "node never in a list", at: &DLLNode[int]{Data: &val}, want: ErrRemovedNode},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if n, err := list.InsertChecked(tt.at, &val); n != nil || !errors.Is(err, tt.want) {
+				t.Errorf("InsertChecked: got %v, %v; want nil, %v", n, err, tt.want)
+			}
+			if n, err := list.RemoveChecked(tt.at); n != nil || !errors.Is(err, tt.want) {
+				t.Errorf("RemoveChecked: got %v, %v; want nil, %v", n, err, tt.want)
+			}
+			if list.Insert(tt.at, &val) != nil || list.Remove(tt.at) != nil {
+				t.Error("Insert and Remove should return nil")
+			}
+
+			checkList(t, list)
+			checkList(t, other)
+			if got := list.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+				t.Errorf("list changed to %v", got)
+			}
+			if got := other.ToSlice(); !slices.Equal(got, []int{7, 8}) {
+				t.Errorf("other list changed to %v", got)
+			}
+		})
+	}
+}
+
+func TestDListSentinels(t *testing.T) {
+	list := createDListFromSlice([]int{1})
+	val := 5
+
+	if _, err := list.RemoveChecked(list.End()); !errors.Is(err, ErrSentinelNode) {
+		t.Errorf("remove tail: got %v; want %v", err, ErrSentinelNode)
+	}
+	if _, err := list.RemoveChecked(list.Begin().Prev()); !errors.Is(err, ErrSentinelNode) {
+		t.Errorf("remove head: got %v; want %v", err, ErrSentinelNode)
+	}
+	if _, err := list.InsertChecked(list.Begin().Prev(), &val); !errors.Is(err, ErrSentinelNode) {
+		t.Errorf("insert before head: got %v; want %v", err, ErrSentinelNode)
+	}
+	checkList(t, list)
+}
+
+func TestDListOwnershipAfterMove(t *testing.T) {
+	moves := []struct {
+		name string
+		move func(l, other *DoublyLinkedList[int])
+	}{
+		{
+			name: "merge",
+			move: func(l, other *DoublyLinkedList[int]) { l.MergeSorted(other, compInts) },
+		}, {
+			name: "splice",
+			move: func(l, other *DoublyLinkedList[int]) { l.SpliceFrom(l.End(), other, other.Begin(), other.End()) },
+		},
+	}
+
+	for _, tt := range moves {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice([]int{1, 3})
+			other := createDListFromSlice([]int{2, 4})
+			moved := other.Begin()
+
+			tt.move(list, other)
+
+			if _, err := other.RemoveChecked(moved); !errors.Is(err, ErrForeignNode) {
+				t.Errorf("got %v; want %v", err, ErrForeignNode)
+			}
+			if _, err := list.RemoveChecked(moved); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			checkList(t, list)
+			checkList(t, other)
+		})
+	}
+}
+
+func TestDListValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		corrupt func(*DoublyLinkedList[int])
+	}{
+		{name: "length too large", corrupt: func(l *DoublyLinkedList[int]) { l.length++ }},
+		{name: "length too small", corrupt: func(l *DoublyLinkedList[int]) { l.length-- }},
+		{name: "broken prev link", corrupt: func(l *DoublyLinkedList[int]) { l.head.next.next.prev = &l.head }},
+		{name: "tail prev stale", corrupt: func(l *DoublyLinkedList[int]) { l.tail.prev = l.head.next }},
+		{name: "tail unreachable", corrupt: func(l *DoublyLinkedList[int]) { l.head.next.next = nil }},
+		{name: "foreign node", corrupt: func(l *DoublyLinkedList[int]) { l.head.next.list = NewDLL[int]() }},
+		{name: "head has predecessor", corrupt: func(l *DoublyLinkedList[int]) { l.head.prev = &l.tail }},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createDListFromSlice([]int{1, 2, 3})
+			if err := list.Validate(); err != nil {
+				t.Fatalf("unexpected error before corrupting: %v", err)
+			}
+
+			tt.corrupt(list)
+			if err := list.Validate(); !errors.Is(err, ErrCorruptList) {
+				t.Errorf("got %v; want %v", err, ErrCorruptList)
+			}
+		})
+	}
+}
+
+func TestDListValidateEdge(t *testing.T) {
+	var list *DoublyLinkedList[int]
+	if err := list.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewDLL[int]().Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDListFindRejectsForeignNodes(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2, 3})
+	other := createDListFromSlice([]int{7, 8})
+
+	removed := list.Begin()
+	list.Remove(removed)
+
+	cases := []struct {
+		name     string
+		from, to *DLLNode[int]
+	}{
+		{name: "nil from", from: nil, to: list.End()},
+		{name: "removed from", from: removed, to: list.End()},
+		{name: "foreign from", from: other.Begin(), to: list.End()},
+		{name: "foreign to", from: list.Begin(), to: other.End()},
+		{name: "to before from", from: list.Begin().Next(), to: list.Begin()},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			target := 9
+			if got := list.Find(tt.from, tt.to, &target, compInts); got != nil {
+				t.Errorf("Find: got %v; want nil", got)
+			}
+			if got := list.ForEach(tt.from, tt.to, func(*int) bool { return true }); got != nil {
+				t.Errorf("ForEach: got %v; want nil", got)
+			}
+
+			found := NewDLL[int]()
+			list.MultiFind(tt.from, tt.to, &target, compInts, found)
+			if !found.IsEmpty() {
+				t.Errorf("MultiFind: got %v; want nothing", found.ToSlice())
+			}
+			checkList(t, list)
+		})
+	}
+}
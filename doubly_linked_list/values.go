@@ -0,0 +1,68 @@
+package doublylinkedlist
+
+// PushBackValue appends a copy of val to the list and returns its node.
+func (l *DoublyLinkedList[T]) PushBackValue(val T) *DLLNode[T] {
+	return l.PushBack(&val)
+}
+
+// PushFrontValue prepends a copy of val to the list and returns its node.
+func (l *DoublyLinkedList[T]) PushFrontValue(val T) *DLLNode[T] {
+	return l.PushFront(&val)
+}
+
+// PopFrontValue removes the first node and returns its value. The bool is
+// false if the list is empty.
+func (l *DoublyLinkedList[T]) PopFrontValue() (T, bool) {
+	if l.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return valueOf(l.PopFront()), true
+}
+
+// PopBackValue removes the last node and returns its value. The bool is false
+// if the list is empty.
+func (l *DoublyLinkedList[T]) PopBackValue() (T, bool) {
+	if l.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return valueOf(l.PopBack()), true
+}
+
+// Values returns a copy of every element in order, one per node. Unlike
+// ToSlice it keeps nodes whose Data is nil, as the zero value, so the result
+// always has Len elements and lines up with IndexOf.
+func (l *DoublyLinkedList[T]) Values() []T {
+	s := make([]T, 0, l.Len())
+	for n := range l.Nodes() {
+		s = append(s, valueOf(n.Data))
+	}
+	return s
+}
+
+// IndexOf returns the position of the first node whose value compares equal
+// to val, or -1 if there is none. Nodes whose Data is nil never match.
+func (l *DoublyLinkedList[T]) IndexOf(val T, comp func(T, T) int) int {
+	i := 0
+	for n := range l.Nodes() {
+		if n.Data != nil && comp(*n.Data, val) == 0 {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Contains reports whether any node's value compares equal to val.
+func (l *DoublyLinkedList[T]) Contains(val T, comp func(T, T) int) bool {
+	return l.IndexOf(val, comp) >= 0
+}
+
+func valueOf[T any](p *T) T {
+	if p == nil {
+		var noop T
+		return noop
+	}
+	return *p
+}
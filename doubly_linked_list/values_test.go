@@ -0,0 +1,124 @@
+package doublylinkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestDListPushBackValue(t *testing.T) {
+	list := NewDLL[int]()
+	for i := range 5 {
+		if n := list.PushBackValue(i); n == nil || *n.Data != i {
+			t.Fatalf("got node %v for %d", n, i)
+		}
+	}
+	checkList(t, list)
+
+	if got, want := list.Values(), []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestDListPushFrontValue(t *testing.T) {
+	list := createDListFromSlice([]int{3})
+	list.PushFrontValue(2)
+	list.PushFrontValue(1)
+	checkList(t, list)
+
+	if got, want := list.Values(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestDListPopValue(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2, 3})
+	list.PushBack(nil)
+
+	pops := []struct {
+		name   string
+		pop    func() (int, bool)
+		want   int
+		wantOk bool
+	}{
+		{name: "front", pop: list.PopFrontValue, want: 1, wantOk: true},
+		{name: "nil data at back", pop: list.PopBackValue, want: 0, wantOk: true},
+		{name: "back", pop: list.PopBackValue, want: 3, wantOk: true},
+		{name: "last element", pop: list.PopFrontValue, want: 2, wantOk: true},
+		{name: "empty front", pop: list.PopFrontValue, want: 0, wantOk: false},
+		{name: "empty back", pop: list.PopBackValue, want: 0, wantOk: false},
+	}
+
+	for _, tt := range pops {
+		got, ok := tt.pop()
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("%s: got %d, %t; want %d, %t", tt.name, got, ok, tt.want, tt.wantOk)
+		}
+		checkList(t, list)
+	}
+}
+
+func TestDListValuesNilData(t *testing.T) {
+	list := createDListFromSlice([]int{1, 2})
+	list.Insert(list.Begin(), nil)
+
+	if got, want := list.Values(), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Values: got %v; want %v", got, want)
+	}
+	if got, want := list.ToSlice(), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice: got %v; want %v", got, want)
+	}
+}
+
+func TestDListIndexOf(t *testing.T) {
+	list := createDListFromSlice([]int{5, 0, 7, 5})
+	list.Insert(list.Begin(), nil)
+
+	cases := []struct {
+		name string
+		val  int
+		want int
+	}{
+		{name: "first", val: 5, want: 1},
+		{name: "zero value", val: 0, want: 2},
+		{name: "last", val: 7, want: 3},
+		{name: "missing", val: 9, want: -1},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := list.IndexOf(tt.val, cmp.Compare[int]); got != tt.want {
+				t.Errorf("IndexOf: got %d; want %d", got, tt.want)
+			}
+			if got := list.Contains(tt.val, cmp.Compare[int]); got != (tt.want >= 0) {
+				t.Errorf("Contains: got %t; want %t", got, tt.want >= 0)
+			}
+			if tt.want >= 0 && list.Values()[tt.want] != tt.val {
+				t.Errorf("index %d does not match Values", tt.want)
+			}
+		})
+	}
+}
+
+func TestDListValuesEdge(t *testing.T) {
+	var list *DoublyLinkedList[int]
+
+	if n := list.PushBackValue(1); n != nil {
+		t.Errorf("got node %v; want nil", n)
+	}
+	if _, ok := list.PopFrontValue(); ok {
+		t.Error("nil list should have nothing to pop")
+	}
+	if _, ok := list.PopBackValue(); ok {
+		t.Error("nil list should have nothing to pop")
+	}
+	if got := list.Values(); len(got) != 0 {
+		t.Errorf("got %v; want empty", got)
+	}
+	if got := list.IndexOf(1, cmp.Compare[int]); got != -1 {
+		t.Errorf("got %d; want -1", got)
+	}
+	if list.Contains(1, cmp.Compare[int]) {
+		t.Error("nil list should contain nothing")
+	}
+}
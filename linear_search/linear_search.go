@@ -8,3 +8,36 @@ func LinearSearch[S ~[]T, T comparable](needle T, haystack S) (int, bool) {
 	}
 	return 0, false
 }
+
+// LinearSearchFunc returns the index of the first element for which pred
+// returns true.
+func LinearSearchFunc[S ~[]T, T any](haystack S, pred func(T) bool) (int, bool) {
+	for i, ele := range haystack {
+		if pred(ele) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// LinearSearchLast returns the index of the last element equal to needle.
+func LinearSearchLast[S ~[]T, T comparable](needle T, haystack S) (int, bool) {
+	for i := len(haystack) - 1; i >= 0; i-- {
+		if haystack[i] == needle {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// LinearSearchAll returns the indices of every element equal to needle, in
+// increasing order. It returns an empty slice when there are none.
+func LinearSearchAll[S ~[]T, T comparable](needle T, haystack S) []int {
+	ret := []int{}
+	for i, ele := range haystack {
+		if ele == needle {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
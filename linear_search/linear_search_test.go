@@ -1,6 +1,7 @@
 package linearsearch
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -122,3 +123,96 @@ func TestLinearSearchEmptyArr(t *testing.T) {
 		}
 	}
 }
+
+func TestLinearSearchFunc(t *testing.T) {
+	type item struct {
+		name string
+		tags []string
+	}
+
+	items := []item{
+		{name: "a"},
+		{name: "b", tags: []string{"x"}},
+		{name: "c", tags: []string{"x", "y"}},
+	}
+
+	gotIdx, got := LinearSearchFunc(items, func(it item) bool { return len(it.tags) > 0 })
+	if !got || gotIdx != 1 {
+		t.Errorf("got (%d, %t); want (1, true)", gotIdx, got)
+	}
+
+	gotIdx, got = LinearSearchFunc(items, func(it item) bool { return len(it.tags) > 2 })
+	if got || gotIdx != 0 {
+		t.Errorf("got (%d, %t); want (0, false)", gotIdx, got)
+	}
+}
+
+func TestLinearSearchLast(t *testing.T) {
+	arr := []int{4, 1, 4, 2, 4, 3}
+	cases := []struct {
+		name    string
+		toFind  int
+		want    bool
+		wantIdx int
+	}{
+		{
+			name:    "4 exists several times",
+			toFind:  4,
+			want:    true,
+			wantIdx: 4,
+		}, {
+			name:    "3 exists at the end",
+			toFind:  3,
+			want:    true,
+			wantIdx: 5,
+		}, {
+			name:    "5 doesn't exist",
+			toFind:  5,
+			want:    false,
+			wantIdx: 0,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIdx, got := LinearSearchLast(tt.toFind, arr)
+			if got != tt.want {
+				t.Errorf("got %t; want %t", got, tt.want)
+			}
+			if gotIdx != tt.wantIdx {
+				t.Errorf("got index %d; want index %d", gotIdx, tt.wantIdx)
+			}
+		})
+	}
+}
+
+func TestLinearSearchAll(t *testing.T) {
+	arr := []string{"go", "rust", "go", "zig", "go"}
+	cases := []struct {
+		name   string
+		toFind string
+		want   []int
+	}{
+		{
+			name:   "go exists several times",
+			toFind: "go",
+			want:   []int{0, 2, 4},
+		}, {
+			name:   "zig exists once",
+			toFind: "zig",
+			want:   []int{3},
+		}, {
+			name:   "c doesn't exist",
+			toFind: "c",
+			want:   []int{},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LinearSearchAll(tt.toFind, arr); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v; want %v", got, tt.want)
+			}
+		})
+	}
+}
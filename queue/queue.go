@@ -0,0 +1,169 @@
+package queue
+
+import (
+	"iter"
+
+	doublylinkedlist "github.com/zukofett/go_algo/doubly_linked_list"
+)
+
+// Deque is a double-ended queue backed by a doubly linked list. The zero
+// value is an empty deque ready to use.
+type Deque[T any] struct {
+	list *doublylinkedlist.DoublyLinkedList[T]
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{
+		list: doublylinkedlist.NewDLL[T](),
+	}
+}
+
+func (d *Deque[T]) PushFront(val T) {
+	if d == nil {
+		return
+	}
+	d.init()
+	d.list.PushFront(&val)
+}
+
+func (d *Deque[T]) PushBack(val T) {
+	if d == nil {
+		return
+	}
+	d.init()
+	d.list.PushBack(&val)
+}
+
+// init creates the list on first use so the zero value works.
+func (d *Deque[T]) init() {
+	if d.list == nil {
+		d.list = doublylinkedlist.NewDLL[T]()
+	}
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return *d.list.PopFront(), true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return *d.list.PopBack(), true
+}
+
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return *d.list.Begin().Data, true
+}
+
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return *d.list.End().Prev().Data, true
+}
+
+func (d *Deque[T]) Len() int {
+	if d == nil {
+		return 0
+	}
+	return d.list.Len()
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.Len() == 0
+}
+
+func (d *Deque[T]) ToSlice() []T {
+	if d == nil {
+		return []T{}
+	}
+	return d.list.ToSlice()
+}
+
+// All yields the elements from the front of the deque to the back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	if d == nil {
+		return func(func(T) bool) {}
+	}
+	return d.list.All()
+}
+
+// Queue is a FIFO queue backed by a doubly linked list. The zero value is an
+// empty queue ready to use.
+type Queue[T any] struct {
+	deque Deque[T]
+}
+
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{
+		deque: *NewDeque[T](),
+	}
+}
+
+func (q *Queue[T]) Enqueue(val T) {
+	if q == nil {
+		return
+	}
+	q.deque.PushBack(val)
+}
+
+func (q *Queue[T]) Dequeue() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PopFront()
+}
+
+func (q *Queue[T]) PeekFront() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PeekFront()
+}
+
+func (q *Queue[T]) PeekBack() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PeekBack()
+}
+
+func (q *Queue[T]) Len() int {
+	if q == nil {
+		return 0
+	}
+	return q.deque.Len()
+}
+
+func (q *Queue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+func (q *Queue[T]) ToSlice() []T {
+	if q == nil {
+		return []T{}
+	}
+	return q.deque.ToSlice()
+}
+
+// All yields the elements in the order they would be dequeued.
+func (q *Queue[T]) All() iter.Seq[T] {
+	if q == nil {
+		return func(func(T) bool) {}
+	}
+	return q.deque.All()
+}
@@ -0,0 +1,265 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+type testDeque interface {
+	PushFront(int)
+	PushBack(int)
+	PopFront() (int, bool)
+	PopBack() (int, bool)
+	PeekFront() (int, bool)
+	PeekBack() (int, bool)
+	Len() int
+	IsEmpty() bool
+	ToSlice() []int
+}
+
+type testQueue interface {
+	Enqueue(int)
+	Dequeue() (int, bool)
+	PeekFront() (int, bool)
+	PeekBack() (int, bool)
+	Len() int
+	IsEmpty() bool
+	ToSlice() []int
+}
+
+var testDeques = []struct {
+	name string
+	new  func() testDeque
+}{
+	{
+		name: "Deque",
+		new:  func() testDeque { return NewDeque[int]() },
+	}, {
+		name: "RingDeque",
+		new:  func() testDeque { return NewRingDeque[int](2) },
+	}, {
+		name: "zero Deque",
+		new:  func() testDeque { return &Deque[int]{} },
+	}, {
+		name: "zero RingDeque",
+		new:  func() testDeque { return &RingDeque[int]{} },
+	},
+}
+
+var testQueues = []struct {
+	name string
+	new  func() testQueue
+}{
+	{
+		name: "Queue",
+		new:  func() testQueue { return NewQueue[int]() },
+	}, {
+		name: "RingQueue",
+		new:  func() testQueue { return NewRingQueue[int](2) },
+	}, {
+		name: "zero Queue",
+		new:  func() testQueue { return &Queue[int]{} },
+	}, {
+		name: "zero RingQueue",
+		new:  func() testQueue { return &RingQueue[int]{} },
+	},
+}
+
+func TestQueue(t *testing.T) {
+	cases := []struct {
+		name     string
+		enqueue  []int
+		dequeue  int
+		want     []int
+		wantVals []int
+	}{
+		{
+			name:     "empty queue",
+			enqueue:  []int{},
+			dequeue:  1,
+			want:     []int{},
+			wantVals: []int{},
+		}, {
+			name:     "first in first out",
+			enqueue:  []int{1, 2, 3},
+			dequeue:  2,
+			want:     []int{1, 2},
+			wantVals: []int{3},
+		}, {
+			name:     "grow past initial capacity",
+			enqueue:  []int{1, 2, 3, 4, 5, 6, 7},
+			dequeue:  3,
+			want:     []int{1, 2, 3},
+			wantVals: []int{4, 5, 6, 7},
+		}, {
+			name:     "dequeue more than enqueued",
+			enqueue:  []int{1, 2},
+			dequeue:  4,
+			want:     []int{1, 2},
+			wantVals: []int{},
+		},
+	}
+
+	for _, impl := range testQueues {
+		for _, tt := range cases {
+			t.Run(impl.name+"/"+tt.name, func(t *testing.T) {
+				q := impl.new()
+				for _, val := range tt.enqueue {
+					q.Enqueue(val)
+				}
+
+				got := []int{}
+				for range tt.dequeue {
+					if val, ok := q.Dequeue(); ok {
+						got = append(got, val)
+					}
+				}
+
+				if !slices.Equal(got, tt.want) {
+					t.Fatalf("got dequeued %v; want %v", got, tt.want)
+				}
+
+				if vals := q.ToSlice(); !slices.Equal(vals, tt.wantVals) {
+					t.Fatalf("got %v; want %v", vals, tt.wantVals)
+				}
+
+				if q.Len() != len(tt.wantVals) {
+					t.Fatalf("got len %d; want %d", q.Len(), len(tt.wantVals))
+				}
+
+				front, okFront := q.PeekFront()
+				back, okBack := q.PeekBack()
+				if len(tt.wantVals) == 0 {
+					if okFront || okBack || !q.IsEmpty() {
+						t.Fatal("expected empty queue")
+					}
+					return
+				}
+
+				if !okFront || front != tt.wantVals[0] {
+					t.Errorf("got front (%d, %t); want %d", front, okFront, tt.wantVals[0])
+				}
+				if !okBack || back != tt.wantVals[len(tt.wantVals)-1] {
+					t.Errorf("got back (%d, %t); want %d", back, okBack, tt.wantVals[len(tt.wantVals)-1])
+				}
+			})
+		}
+	}
+}
+
+func TestDeque(t *testing.T) {
+	for _, impl := range testDeques {
+		t.Run(impl.name, func(t *testing.T) {
+			d := impl.new()
+
+			d.PushBack(2)
+			d.PushFront(1)
+			d.PushBack(3)
+			d.PushFront(0)
+			d.PushBack(4)
+
+			if got, want := d.ToSlice(), []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+				t.Fatalf("got %v; want %v", got, want)
+			}
+
+			if val, ok := d.PeekFront(); !ok || val != 0 {
+				t.Errorf("got front (%d, %t); want 0", val, ok)
+			}
+			if val, ok := d.PeekBack(); !ok || val != 4 {
+				t.Errorf("got back (%d, %t); want 4", val, ok)
+			}
+
+			if val, ok := d.PopBack(); !ok || val != 4 {
+				t.Errorf("got (%d, %t); want 4", val, ok)
+			}
+			if val, ok := d.PopFront(); !ok || val != 0 {
+				t.Errorf("got (%d, %t); want 0", val, ok)
+			}
+
+			if got, want := d.ToSlice(), []int{1, 2, 3}; !slices.Equal(got, want) {
+				t.Fatalf("got %v; want %v", got, want)
+			}
+
+			for range 3 {
+				d.PopBack()
+			}
+
+			if _, ok := d.PopFront(); ok {
+				t.Error("expected PopFront on empty deque to report false")
+			}
+			if _, ok := d.PopBack(); ok {
+				t.Error("expected PopBack on empty deque to report false")
+			}
+			if !d.IsEmpty() {
+				t.Errorf("got len %d; want 0", d.Len())
+			}
+		})
+	}
+}
+
+func TestQueueEdge(t *testing.T) {
+	defer func() {
+		if err := recover(); err != nil {
+			t.Error("expected not to panic but did")
+		}
+	}()
+
+	var q *Queue[int]
+	q.Enqueue(1)
+	if _, ok := q.Dequeue(); ok || !q.IsEmpty() {
+		t.Error("expected nil queue to be empty")
+	}
+
+	var rq *RingQueue[int]
+	rq.Enqueue(1)
+	if _, ok := rq.Dequeue(); ok || !rq.IsEmpty() {
+		t.Error("expected nil ring queue to be empty")
+	}
+
+	var d *Deque[int]
+	d.PushFront(1)
+	if _, ok := d.PopBack(); ok || len(slices.Collect(d.All())) != 0 {
+		t.Error("expected nil deque to be empty")
+	}
+
+	var rd *RingDeque[int]
+	rd.PushFront(1)
+	if _, ok := rd.PopBack(); ok || len(slices.Collect(rd.All())) != 0 {
+		t.Error("expected nil ring deque to be empty")
+	}
+}
+
+func BenchmarkQueues(b *testing.B) {
+	for _, impl := range testQueues {
+		b.Run(impl.name, func(b *testing.B) {
+			q := impl.new()
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(i)
+				if i%2 == 1 {
+					q.Dequeue()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDeques(b *testing.B) {
+	for _, impl := range testDeques {
+		b.Run(impl.name, func(b *testing.B) {
+			d := impl.new()
+			for i := 0; i < b.N; i++ {
+				switch i % 4 {
+				case 0:
+					d.PushBack(i)
+				case 1:
+					d.PushFront(i)
+				case 2:
+					d.PopFront()
+					d.PushBack(i)
+				default:
+					d.PopBack()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package queue
+
+import "iter"
+
+// RingDeque is a double-ended queue backed by a growable ring buffer. It has
+// the same API as Deque but keeps its elements contiguous in memory.
+type RingDeque[T any] struct {
+	buf    []T
+	front  int
+	length int
+}
+
+func NewRingDeque[T any](base int) *RingDeque[T] {
+	return &RingDeque[T]{
+		buf: make([]T, base),
+	}
+}
+
+func (d *RingDeque[T]) PushFront(val T) {
+	if d == nil {
+		return
+	}
+	d.grow()
+	d.front = d.index(len(d.buf) - 1)
+	d.buf[d.front] = val
+	d.length++
+}
+
+func (d *RingDeque[T]) PushBack(val T) {
+	if d == nil {
+		return
+	}
+	d.grow()
+	d.buf[d.index(d.length)] = val
+	d.length++
+}
+
+func (d *RingDeque[T]) PopFront() (T, bool) {
+	var noop T
+	if d.IsEmpty() {
+		return noop, false
+	}
+
+	val := d.buf[d.front]
+	d.buf[d.front] = noop
+	d.front = d.index(1)
+	d.length--
+	return val, true
+}
+
+func (d *RingDeque[T]) PopBack() (T, bool) {
+	var noop T
+	if d.IsEmpty() {
+		return noop, false
+	}
+
+	back := d.index(d.length - 1)
+	val := d.buf[back]
+	d.buf[back] = noop
+	d.length--
+	return val, true
+}
+
+func (d *RingDeque[T]) PeekFront() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return d.buf[d.front], true
+}
+
+func (d *RingDeque[T]) PeekBack() (T, bool) {
+	if d.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return d.buf[d.index(d.length-1)], true
+}
+
+func (d *RingDeque[T]) Len() int {
+	if d == nil {
+		return 0
+	}
+	return d.length
+}
+
+func (d *RingDeque[T]) IsEmpty() bool {
+	return d.Len() == 0
+}
+
+func (d *RingDeque[T]) ToSlice() []T {
+	ret := make([]T, 0, d.Len())
+	for val := range d.All() {
+		ret = append(ret, val)
+	}
+	return ret
+}
+
+// All yields the elements from the front of the deque to the back.
+func (d *RingDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.Len() {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// index maps a distance from the front of the deque to a buffer position.
+func (d *RingDeque[T]) index(i int) int {
+	return (d.front + i) % len(d.buf)
+}
+
+// grow doubles the buffer when it is full, unrolling the ring so the front
+// element lands at position zero.
+func (d *RingDeque[T]) grow() {
+	if d.length < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(d.buf), 1))
+	for i := range d.length {
+		buf[i] = d.buf[d.index(i)]
+	}
+	d.buf = buf
+	d.front = 0
+}
+
+// RingQueue is a FIFO queue backed by a growable ring buffer. It has the same
+// API as Queue.
+type RingQueue[T any] struct {
+	deque RingDeque[T]
+}
+
+func NewRingQueue[T any](base int) *RingQueue[T] {
+	return &RingQueue[T]{
+		deque: *NewRingDeque[T](base),
+	}
+}
+
+func (q *RingQueue[T]) Enqueue(val T) {
+	if q == nil {
+		return
+	}
+	q.deque.PushBack(val)
+}
+
+func (q *RingQueue[T]) Dequeue() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PopFront()
+}
+
+func (q *RingQueue[T]) PeekFront() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PeekFront()
+}
+
+func (q *RingQueue[T]) PeekBack() (T, bool) {
+	if q == nil {
+		var noop T
+		return noop, false
+	}
+	return q.deque.PeekBack()
+}
+
+func (q *RingQueue[T]) Len() int {
+	if q == nil {
+		return 0
+	}
+	return q.deque.Len()
+}
+
+func (q *RingQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+func (q *RingQueue[T]) ToSlice() []T {
+	if q == nil {
+		return []T{}
+	}
+	return q.deque.ToSlice()
+}
+
+// All yields the elements in the order they would be dequeued.
+func (q *RingQueue[T]) All() iter.Seq[T] {
+	if q == nil {
+		return func(func(T) bool) {}
+	}
+	return q.deque.All()
+}
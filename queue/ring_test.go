@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingDequeWrapAround(t *testing.T) {
+	d := NewRingDeque[int](4)
+
+	for i := range 4 {
+		d.PushBack(i)
+	}
+	d.PopFront()
+	d.PopFront()
+	d.PushBack(4)
+	d.PushBack(5)
+
+	if len(d.buf) != 4 {
+		t.Fatalf("got buffer len %d; want 4", len(d.buf))
+	}
+
+	if got, want := d.ToSlice(), []int{2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+
+	d.PushFront(1)
+
+	if len(d.buf) != 8 {
+		t.Fatalf("got buffer len %d; want 8", len(d.buf))
+	}
+
+	if got, want := d.ToSlice(), []int{1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
+
+func TestRingDequeZeroBase(t *testing.T) {
+	d := NewRingDeque[int](0)
+
+	d.PushFront(1)
+	d.PushFront(0)
+	d.PushBack(2)
+
+	if got, want := d.ToSlice(), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
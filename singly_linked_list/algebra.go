@@ -0,0 +1,115 @@
+package singlylinkedlist
+
+// Reverse reverses the order of the list in place by relinking its nodes.
+func (l *SinglyLinkedList[T]) Reverse() {
+	if l == nil || l.length < 2 {
+		return
+	}
+
+	// Starting from the sentinel makes the old head point at it.
+	prev := l.tail
+	for n := l.head; n != l.tail; {
+		next := n.next
+		n.next = prev
+		prev, n = n, next
+	}
+	l.head = prev
+}
+
+// MergeSorted merges other into l. Both lists must already be sorted by comp.
+// The nodes of other are moved rather than copied, so other is left empty
+// and its *Node handles now belong to l. The merge is stable, with elements
+// of l ahead of equal elements of other, and nil Data sorts last like Sort.
+func (l *SinglyLinkedList[T]) MergeSorted(other *SinglyLinkedList[T], comp func(*T, *T) int) {
+	if l == nil || other == nil || l == other || other.length == 0 {
+		return
+	}
+
+	for n := range other.Nodes() {
+		n.list = l
+	}
+
+	a, b := l.detach(), other.detach()
+	first, last := mergeNodes(a, b, comp)
+	l.head = first
+	last.next = l.tail
+	l.length += other.length
+
+	other.head = other.tail
+	other.length = 0
+}
+
+// detach cuts the sentinel off the list and returns its nodes as a
+// nil-terminated chain. The list must be relinked before it is used again.
+func (l *SinglyLinkedList[T]) detach() *Node[T] {
+	if l.length == 0 {
+		return nil
+	}
+
+	last := l.head
+	for last.next != l.tail {
+		last = last.next
+	}
+	last.next = nil
+	return l.head
+}
+
+// Unique removes every node whose Data compares equal to the node before it,
+// so runs of equal elements are reduced to their first node. Two nil Data
+// count as equal. It returns the number of nodes removed.
+func (l *SinglyLinkedList[T]) Unique(comp func(*T, *T) int) int {
+	if l == nil || l.length < 2 {
+		return 0
+	}
+
+	removed := 0
+	prev := l.head
+	for n := prev.next; n != l.tail; n = prev.next {
+		if equal(prev.Data, n.Data, comp) {
+			prev.next = n.next
+			unlink(n)
+			removed++
+		} else {
+			prev = n
+		}
+	}
+	l.length -= removed
+	return removed
+}
+
+func equal[T any](a, b *T, comp func(*T, *T) int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return comp(a, b) == 0
+}
+
+// RemoveIf removes every node for which pred returns true and returns how
+// many were removed. Like ForEach, it skips nodes whose Data is nil.
+func (l *SinglyLinkedList[T]) RemoveIf(pred func(*T) bool) int {
+	if l == nil {
+		return 0
+	}
+
+	removed := 0
+	for link := &l.head; *link != l.tail; {
+		n := *link
+		if n.Data != nil && pred(n.Data) {
+			*link = n.next
+			unlink(n)
+			removed++
+		} else {
+			link = &n.next
+		}
+	}
+	l.length -= removed
+	return removed
+}
+
+// Filter keeps only the nodes for which keep returns true and returns how
+// many were removed. Nodes whose Data is nil are kept.
+func (l *SinglyLinkedList[T]) Filter(keep func(*T) bool) int {
+	return l.RemoveIf(func(val *T) bool {
+		return !keep(val)
+	})
+}
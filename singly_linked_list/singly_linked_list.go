@@ -1,8 +1,23 @@
 package singlylinkedlist
 
+import (
+	"errors"
+	"iter"
+)
+
+var (
+	ErrForeignNode = errors.New("singlylinkedlist: node belongs to another list")
+	ErrRemovedNode = errors.New("singlylinkedlist: node is not in a list")
+	ErrEndNode     = errors.New("singlylinkedlist: cannot remove the end node")
+)
+
+// Node is an element of a SinglyLinkedList. It remembers the list it belongs
+// to, so nodes from another list or nodes that have been removed are
+// rejected instead of corrupting the list.
 type Node[T any] struct {
 	Data *T
 	next *Node[T]
+	list *SinglyLinkedList[T]
 }
 
 func (n *Node[T]) Next() *Node[T] {
@@ -12,17 +27,23 @@ func (n *Node[T]) Next() *Node[T] {
 	return n.next
 }
 
+// SinglyLinkedList holds pointers to its elements so callers can share data
+// with it without copying. Begin is the first node and End is an empty
+// sentinel after the last one.
+//
+// A node whose Data is nil has no value. Insert accepts nil, and ToSlice,
+// All, Find and ForEach skip such nodes. PushBackValue never stores nil, and
+// Values reads it as the zero value of T so its indices match IndexOf.
 type SinglyLinkedList[T any] struct {
 	head, tail *Node[T]
 	length     int
 }
 
 func NewSLL[T any]() *SinglyLinkedList[T] {
-	dummy := &Node[T]{}
-	return &SinglyLinkedList[T]{
-		head: dummy,
-		tail: dummy,
-	}
+	l := &SinglyLinkedList[T]{}
+	l.head = &Node[T]{list: l}
+	l.tail = l.head
+	return l
 }
 
 func (l *SinglyLinkedList[T]) Len() int {
@@ -52,13 +73,21 @@ func (l *SinglyLinkedList[T]) End() *Node[T] {
 
 // Insert inserts a new node before 'at'
 func (l *SinglyLinkedList[T]) Insert(at *Node[T], val *T) *Node[T] {
-	if l == nil || at == nil {
-		return nil
+	n, _ := l.InsertChecked(at, val)
+	return n
+}
+
+// InsertChecked is Insert, but reports why nothing was inserted: at is nil,
+// removed or belongs to another list.
+func (l *SinglyLinkedList[T]) InsertChecked(at *Node[T], val *T) (*Node[T], error) {
+	if err := l.checkNode(at); err != nil {
+		return nil, err
 	}
 
 	newNode := &Node[T]{
 		Data: at.Data,
 		next: at.next,
+		list: l,
 	}
 
 	at.Data = val
@@ -68,31 +97,70 @@ func (l *SinglyLinkedList[T]) Insert(at *Node[T], val *T) *Node[T] {
 	if at == l.tail {
 		l.tail = newNode
 	}
-	return at
+	return at, nil
 }
 
 func (l *SinglyLinkedList[T]) Remove(at *Node[T]) *Node[T] {
-	if l == nil || at == nil || at.next == nil {
-		return nil
+	n, _ := l.RemoveChecked(at)
+	return n
+}
+
+// RemoveChecked is Remove, but reports why nothing was removed: at is nil,
+// removed, belongs to another list or is End.
+//
+// Removing copies the next node's Data into at and drops the next node, so
+// it is the handle of the next node that stops being part of the list.
+func (l *SinglyLinkedList[T]) RemoveChecked(at *Node[T]) (*Node[T], error) {
+	if err := l.checkNode(at); err != nil {
+		return nil, err
+	}
+	if at.next == nil {
+		return nil, ErrEndNode
 	}
 
-	if l.tail == at.next {
+	removed := at.next
+	if l.tail == removed {
 		l.tail = at
 	}
 
-	at.Data = at.next.Data
-	at.next = at.next.next
+	at.Data = removed.Data
+	at.next = removed.next
 	l.length--
+	unlink(removed)
+
+	return at, nil
+}
 
-	return at
+// checkNode returns an error unless n is a node of l.
+func (l *SinglyLinkedList[T]) checkNode(n *Node[T]) error {
+	switch {
+	case n == nil || n.list == nil:
+		return ErrRemovedNode
+	case n.list != l:
+		return ErrForeignNode
+	}
+	return nil
 }
 
+// unlink clears a node that has been taken out of a list.
+func unlink[T any](n *Node[T]) {
+	n.next = nil
+	n.Data = nil
+	n.list = nil
+}
+
+// Find returns the first node in [from, to) whose Data compares equal to
+// data, or to if there is none. It returns nil if from or to is not a node
+// of l, or if to does not come after from.
 func (l *SinglyLinkedList[T]) Find(from, to *Node[T], data *T, comp func(*T, *T) int) *Node[T] {
-	if l == nil || from == nil || to == nil {
+	if l.checkNode(from) != nil || l.checkNode(to) != nil {
 		return nil
 	}
 
 	for from != to {
+		if from == l.tail {
+			return nil
+		}
 		if from.Data != nil && comp(from.Data, data) == 0 {
 			return from
 		}
@@ -101,12 +169,18 @@ func (l *SinglyLinkedList[T]) Find(from, to *Node[T], data *T, comp func(*T, *T)
 	return from
 }
 
+// ForEach calls do on the Data of every node in [from, to) until do returns
+// false, and returns the node it stopped at. Like Find, it returns nil if
+// from and to do not delimit a range of l.
 func (l *SinglyLinkedList[T]) ForEach(from, to *Node[T], do func(*T) bool) *Node[T] {
-	if l == nil || from == nil || to == nil {
+	if l.checkNode(from) != nil || l.checkNode(to) != nil {
 		return nil
 	}
 
 	for from != to {
+		if from == l.tail {
+			return nil
+		}
 		if from.Data != nil && !do(from.Data) {
 			return from
 		}
@@ -129,3 +203,42 @@ func (l *SinglyLinkedList[T]) ToSlice() []T {
 	})
 	return s
 }
+
+// Nodes yields every node between Begin and End, including nodes whose Data
+// is nil.
+func (l *SinglyLinkedList[T]) Nodes() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		if l == nil {
+			return
+		}
+		for n := l.Begin(); n != l.End(); n = n.next {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// All yields the values of the list in order, skipping nil Data like ToSlice.
+func (l *SinglyLinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range l.Nodes() {
+			if n.Data != nil && !yield(*n.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed yields the values of the list with their index in ToSlice.
+func (l *SinglyLinkedList[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for val := range l.All() {
+			if !yield(i, val) {
+				return
+			}
+			i++
+		}
+	}
+}
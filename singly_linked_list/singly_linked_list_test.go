@@ -412,6 +412,78 @@ func TestForEach(t *testing.T) {
 	}
 }
 
+func TestIterators(t *testing.T) {
+	cases := []struct {
+		name string
+		init []int
+	}{
+		{
+			name: "empty list",
+			init: []int{},
+		}, {
+			name: "one element",
+			init: []int{1},
+		}, {
+			name: "few elements",
+			init: []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createListFromSlice(tt.init)
+
+			if got := slices.Collect(list.All()); !slices.Equal(got, tt.init) {
+				t.Errorf("All: got %v; want %v", got, tt.init)
+			}
+
+			for i, v := range list.Indexed() {
+				if v != tt.init[i] {
+					t.Errorf("Indexed: got %d at index %d; want %d", v, i, tt.init[i])
+				}
+			}
+
+			nodes := 0
+			for n := range list.Nodes() {
+				if n.Data == nil || *n.Data != tt.init[nodes] {
+					t.Errorf("Nodes: unexpected node data at index %d", nodes)
+				}
+				nodes++
+			}
+			if nodes != list.Len() {
+				t.Errorf("Nodes: got %d nodes; want %d", nodes, list.Len())
+			}
+		})
+	}
+}
+
+func TestIteratorsBreak(t *testing.T) {
+	list := createListFromSlice([]int{1, 2, 3, 4, 5})
+
+	var got []int
+	for v := range list.All() {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+
+	if want := []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestIteratorsEdge(t *testing.T) {
+	var list *SinglyLinkedList[int]
+
+	if got := slices.Collect(list.All()); len(got) != 0 {
+		t.Errorf("got %v; want empty", got)
+	}
+	for range list.Nodes() {
+		t.Error("expected no nodes from nil list")
+	}
+}
+
 /******************************************************************************
                             Helpers
 ******************************************************************************/
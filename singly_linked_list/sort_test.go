@@ -0,0 +1,113 @@
+package singlylinkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	cases := []struct {
+		name string
+		init []int
+	}{
+		{
+			name: "empty list",
+			init: []int{},
+		}, {
+			name: "one element",
+			init: []int{1},
+		}, {
+			name: "reversed",
+			init: []int{5, 4, 3, 2, 1},
+		}, {
+			name: "duplicates",
+			init: []int{3, 1, 3, 0, 1, 2, 0, 3},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createListFromSlice(tt.init)
+			data := make(map[*Node[int]]*int)
+			for n := range list.Nodes() {
+				data[n] = n.Data
+			}
+
+			list.Sort(compInts)
+
+			want := slices.Sorted(slices.Values(tt.init))
+			if got := list.ToSlice(); !slices.Equal(got, want) {
+				t.Errorf("got %v; want %v", got, want)
+			}
+			if list.Len() != len(tt.init) {
+				t.Errorf("got len of %d; want %d", list.Len(), len(tt.init))
+			}
+
+			seen := 0
+			for n := range list.Nodes() {
+				if d, ok := data[n]; !ok || d != n.Data {
+					t.Fatalf("node %p was not in the list or lost its data", n)
+				}
+				seen++
+			}
+			if seen != len(data) {
+				t.Errorf("got %d nodes; want %d", seen, len(data))
+			}
+
+			val := 100
+			list.Insert(list.End(), &val)
+			if got := list.ToSlice(); got[len(got)-1] != val {
+				t.Errorf("insert at end after sort gave %v", got)
+			}
+		})
+	}
+}
+
+func TestSortStable(t *testing.T) {
+	type record struct {
+		key, seq int
+	}
+
+	init := make([]record, 100)
+	for i := range init {
+		init[i] = record{key: (i * 7) % 5, seq: i}
+	}
+	list := createListFromSlice(init)
+
+	list.Sort(func(a, b *record) int {
+		return cmp.Compare(a.key, b.key)
+	})
+
+	want := slices.Clone(init)
+	slices.SortStableFunc(want, func(a, b record) int {
+		return cmp.Compare(a.key, b.key)
+	})
+	if got := list.ToSlice(); !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestSortNilData(t *testing.T) {
+	list := createListFromSlice([]int{3, 1, 2})
+	list.Insert(list.Begin(), nil)
+	list.Insert(list.End(), nil)
+
+	list.Sort(compInts)
+
+	var got []*int
+	for n := range list.Nodes() {
+		got = append(got, n.Data)
+	}
+	if got[3] != nil || got[4] != nil {
+		t.Fatalf("expected nil data at the back")
+	}
+	if vals := list.ToSlice(); !slices.Equal(vals, []int{1, 2, 3}) {
+		t.Errorf("got %v; want [1 2 3]", vals)
+	}
+}
+
+func TestSortEdge(t *testing.T) {
+	var list *SinglyLinkedList[int]
+	list.Sort(compInts)
+}
@@ -0,0 +1,45 @@
+package singlylinkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrCorruptList = errors.New("singlylinkedlist: corrupt list")
+
+// Validate walks the list and checks that End is reachable from Begin in
+// exactly Len steps, that End is the last node and that every node belongs
+// to l. It returns an error wrapping ErrCorruptList that describes the first
+// problem found. It is meant for debugging and tests.
+func (l *SinglyLinkedList[T]) Validate() error {
+	if l == nil {
+		return nil
+	}
+	if l.head == nil || l.tail == nil {
+		return fmt.Errorf("%w: missing head or tail", ErrCorruptList)
+	}
+	if l.tail.next != nil {
+		return fmt.Errorf("%w: end node has a successor", ErrCorruptList)
+	}
+	if l.tail.list != l {
+		return fmt.Errorf("%w: end node belongs to another list", ErrCorruptList)
+	}
+
+	count := 0
+	for n := l.head; n != l.tail; n = n.next {
+		switch {
+		case n == nil:
+			return fmt.Errorf("%w: end is not reachable after %d nodes", ErrCorruptList, count)
+		case n.list != l:
+			return fmt.Errorf("%w: node %d belongs to another list", ErrCorruptList, count)
+		case count == l.length:
+			return fmt.Errorf("%w: more than %d nodes", ErrCorruptList, l.length)
+		}
+		count++
+	}
+
+	if count != l.length {
+		return fmt.Errorf("%w: found %d nodes, want %d", ErrCorruptList, count, l.length)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package singlylinkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestOwnership(t *testing.T) {
+	list := createListFromSlice([]int{1, 2, 3})
+	other := createListFromSlice([]int{7, 8})
+	val := 5
+
+	removed := list.Begin().Next()
+	list.Remove(list.Begin())
+
+	cases := []struct {
+		name string
+		at   *Node[int]
+		want error
+	}{
+		{name: "nil node", at: nil, want: ErrRemovedNode},
+		{name: "node of another list", at: other.Begin(), want: ErrForeignNode},
+		{name: "end of another list", at: other.End(), want: ErrForeignNode},
+		{name: "removed node", at: removed, want: ErrRemovedNode},
+		{name: "node never in a list", at: &Node[int]{Data: &val}, want: ErrRemovedNode},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if n, err := list.InsertChecked(tt.at, &val); n != nil || !errors.Is(err, tt.want) {
+				t.Errorf("InsertChecked: got %v, %v; want nil, %v", n, err, tt.want)
+			}
+			if n, err := list.RemoveChecked(tt.at); n != nil || !errors.Is(err, tt.want) {
+				t.Errorf("RemoveChecked: got %v, %v; want nil, %v", n, err, tt.want)
+			}
+			if list.Insert(tt.at, &val) != nil || list.Remove(tt.at) != nil {
+				t.Error("Insert and Remove should return nil")
+			}
+
+			checkList(t, list)
+			checkList(t, other)
+			if got := list.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+				t.Errorf("list changed to %v", got)
+			}
+			if got := other.ToSlice(); !slices.Equal(got, []int{7, 8}) {
+				t.Errorf("other list changed to %v", got)
+			}
+		})
+	}
+}
+
+func TestRemoveEnd(t *testing.T) {
+	list := createListFromSlice([]int{1})
+
+	if _, err := list.RemoveChecked(list.End()); !errors.Is(err, ErrEndNode) {
+		t.Errorf("got %v; want %v", err, ErrEndNode)
+	}
+}
+
+func TestOwnershipAfterMerge(t *testing.T) {
+	list := createListFromSlice([]int{1, 3})
+	other := createListFromSlice([]int{2, 4})
+	moved := other.Begin()
+
+	list.MergeSorted(other, compInts)
+
+	if _, err := other.RemoveChecked(moved); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("got %v; want %v", err, ErrForeignNode)
+	}
+	if _, err := list.RemoveChecked(moved); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	checkList(t, list)
+	checkList(t, other)
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		corrupt func(*SinglyLinkedList[int])
+	}{
+		{name: "length too large", corrupt: func(l *SinglyLinkedList[int]) { l.length++ }},
+		{name: "length too small", corrupt: func(l *SinglyLinkedList[int]) { l.length-- }},
+		{name: "tail has successor", corrupt: func(l *SinglyLinkedList[int]) { l.tail.next = l.head }},
+		{name: "tail unreachable", corrupt: func(l *SinglyLinkedList[int]) { l.head.next.next = nil }},
+		{name: "foreign node", corrupt: func(l *SinglyLinkedList[int]) { l.head.next.list = NewSLL[int]() }},
+		{name: "cycle", corrupt: func(l *SinglyLinkedList[int]) { l.head.next.next = l.head }},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			list := createListFromSlice([]int{1, 2, 3})
+			if err := list.Validate(); err != nil {
+				t.Fatalf("unexpected error before corrupting: %v", err)
+			}
+
+			tt.corrupt(list)
+			if err := list.Validate(); !errors.Is(err, ErrCorruptList) {
+				t.Errorf("got %v; want %v", err, ErrCorruptList)
+			}
+		})
+	}
+}
+
+func TestValidateEdge(t *testing.T) {
+	var list *SinglyLinkedList[int]
+	if err := list.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := NewSLL[int]().Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestFindRejectsForeignNodes(t *testing.T) {
+	list := createListFromSlice([]int{1, 2, 3})
+	other := createListFromSlice([]int{7, 8})
+
+	removed := list.Begin().Next()
+	list.Remove(list.Begin())
+
+	cases := []struct {
+		name     string
+		from, to *Node[int]
+	}{
+		{name: "nil from", from: nil, to: list.End()},
+		{name: "removed from", from: removed, to: list.End()},
+		{name: "foreign from", from: other.Begin(), to: list.End()},
+		{name: "foreign to", from: list.Begin(), to: other.End()},
+		{name: "to before from", from: list.Begin().Next(), to: list.Begin()},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			target := 9
+			if got := list.Find(tt.from, tt.to, &target, compInts); got != nil {
+				t.Errorf("Find: got %v; want nil", got)
+			}
+			if got := list.ForEach(tt.from, tt.to, func(*int) bool { return true }); got != nil {
+				t.Errorf("ForEach: got %v; want nil", got)
+			}
+			checkList(t, list)
+		})
+	}
+}
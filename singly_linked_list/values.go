@@ -0,0 +1,42 @@
+package singlylinkedlist
+
+// PushBackValue appends a copy of val and returns the node holding it. Since
+// Insert stores the value in the node it is given, that node is the one End
+// returned before the call, and a new sentinel takes its place.
+func (l *SinglyLinkedList[T]) PushBackValue(val T) *Node[T] {
+	return l.Insert(l.End(), &val)
+}
+
+// Values returns a copy of every element in order. It keeps nodes whose Data
+// is nil as the zero value of T, so position i of the result is the i-th node
+// from Begin.
+func (l *SinglyLinkedList[T]) Values() []T {
+	s := make([]T, 0, l.Len())
+	for n := range l.Nodes() {
+		var val T
+		if n.Data != nil {
+			val = *n.Data
+		}
+		s = append(s, val)
+	}
+	return s
+}
+
+// IndexOf returns how many nodes after Begin the first one whose value
+// compares equal to val is, or -1 if there is none. Nodes whose Data is nil
+// are skipped without calling comp.
+func (l *SinglyLinkedList[T]) IndexOf(val T, comp func(T, T) int) int {
+	i := 0
+	for n := range l.Nodes() {
+		if n.Data != nil && comp(*n.Data, val) == 0 {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// Contains reports whether IndexOf finds val.
+func (l *SinglyLinkedList[T]) Contains(val T, comp func(T, T) int) bool {
+	return l.IndexOf(val, comp) >= 0
+}
@@ -0,0 +1,85 @@
+package singlylinkedlist
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestPushBackValue(t *testing.T) {
+	list := createListFromSlice([]int{1, 2})
+	end := list.End()
+
+	n := list.PushBackValue(3)
+	if n != end {
+		t.Error("want the former End node to hold the new value")
+	}
+	if list.End() == end || list.End().Data != nil {
+		t.Error("want a new empty sentinel after the pushed value")
+	}
+	if n.Next() != list.End() {
+		t.Error("want the pushed node right before End")
+	}
+	checkList(t, list)
+
+	if got, want := list.Values(), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestIndexOfFollowsMovedData(t *testing.T) {
+	list := createListFromSlice([]int{1, 2})
+	first := list.Begin()
+	val := 0
+
+	// Insert stores the new value in first and moves 1 to a new node.
+	list.Insert(first, &val)
+
+	if idx := list.IndexOf(1, cmp.Compare[int]); idx != 1 {
+		t.Errorf("got index %d; want 1", idx)
+	}
+	if *first.Data != 0 || *first.Next().Data != 1 {
+		t.Errorf("got %d then %d; want 0 then 1", *first.Data, *first.Next().Data)
+	}
+	if got, want := list.Values(), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestValuesNilData(t *testing.T) {
+	list := createListFromSlice([]int{1, 2})
+	list.Insert(list.Begin(), nil)
+
+	if got, want := list.Values(), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("Values: got %v; want %v", got, want)
+	}
+	if got, want := list.ToSlice(), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("ToSlice: got %v; want %v", got, want)
+	}
+
+	calls := 0
+	idx := list.IndexOf(0, func(a, b int) int {
+		calls++
+		return cmp.Compare(a, b)
+	})
+	if idx != -1 || calls != 2 {
+		t.Errorf("got index %d after %d calls; want -1 after 2", idx, calls)
+	}
+	if list.Contains(0, cmp.Compare[int]) {
+		t.Error("a nil Data should not match the zero value")
+	}
+}
+
+func TestValuesEdge(t *testing.T) {
+	var list *SinglyLinkedList[int]
+
+	if n := list.PushBackValue(1); n != nil {
+		t.Errorf("got node %v; want nil", n)
+	}
+	if got := list.Values(); len(got) != 0 {
+		t.Errorf("got %v; want empty", got)
+	}
+	if list.Contains(1, cmp.Compare[int]) {
+		t.Error("nil list should contain nothing")
+	}
+}
@@ -0,0 +1,97 @@
+package sorting
+
+import "fmt"
+
+// Algorithm names one of the sorts in this package so callers can pick one
+// at runtime and ask about its properties.
+type Algorithm int
+
+const (
+	Insertion Algorithm = iota
+	Selection
+	Shell
+	Merge
+	MergeBottomUp
+	Quick
+	Heap
+	Tim
+	InPlaceMerge
+	Intro
+	Pdq
+)
+
+// Algorithms lists every Algorithm in declaration order.
+var Algorithms = []Algorithm{
+	Insertion,
+	Selection,
+	Shell,
+	Merge,
+	MergeBottomUp,
+	Quick,
+	Heap,
+	Tim,
+	InPlaceMerge,
+	Intro,
+	Pdq,
+}
+
+var algorithmInfo = map[Algorithm]struct {
+	name   string
+	stable bool
+}{
+	Insertion:     {name: "insertion", stable: true},
+	Selection:     {name: "selection", stable: false},
+	Shell:         {name: "shell", stable: false},
+	Merge:         {name: "merge", stable: true},
+	MergeBottomUp: {name: "merge bottom-up", stable: true},
+	Quick:         {name: "quick", stable: false},
+	Heap:          {name: "heap", stable: false},
+	Tim:           {name: "tim", stable: true},
+	InPlaceMerge:  {name: "in-place merge", stable: true},
+	Intro:         {name: "intro", stable: false},
+	Pdq:           {name: "pdq", stable: false},
+}
+
+func (a Algorithm) String() string {
+	if info, ok := algorithmInfo[a]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("Algorithm(%d)", int(a))
+}
+
+// Stable reports whether the algorithm keeps equal elements in their
+// original order.
+func (a Algorithm) Stable() bool {
+	return algorithmInfo[a].stable
+}
+
+// Sort sorts s with the given algorithm. It panics if alg is not one of the
+// constants in this package.
+func Sort[S ~[]T, T any](alg Algorithm, s S, comp func(T, T) int) {
+	switch alg {
+	case Insertion:
+		InsertionSort(s, comp)
+	case Selection:
+		SelectionSort(s, comp)
+	case Shell:
+		ShellSort(s, comp)
+	case Merge:
+		MergeSort(s, comp)
+	case MergeBottomUp:
+		MergeSortBottomUp(s, comp)
+	case Quick:
+		QuickSort(s, comp)
+	case Heap:
+		HeapSort(s, comp)
+	case Tim:
+		TimSort(s, comp)
+	case InPlaceMerge:
+		InPlaceMergeSort(s, comp)
+	case Intro:
+		IntroSort(s, comp)
+	case Pdq:
+		PdqSort(s, comp)
+	default:
+		panic(fmt.Sprintf("sorting: unknown algorithm %v", alg))
+	}
+}
@@ -0,0 +1,52 @@
+package sorting
+
+import "fmt"
+
+// BucketSort sorts s by float keys in [0, 1) returned by key. It spreads the
+// elements over len(s) equal-width buckets and insertion sorts each bucket,
+// which takes O(n) time on average when the keys are uniformly distributed.
+// It is stable and panics if a key is outside [0, 1).
+func BucketSort[S ~[]T, T any, F ~float32 | ~float64](s S, key func(T) F) {
+	n := len(s)
+	if n < 2 {
+		return
+	}
+
+	keys := make([]F, n)
+	buckets := make([]int, n)
+	pos := make([]int, n+1)
+	for i, el := range s {
+		k := key(el)
+		if !(k >= 0 && k < 1) {
+			panic(fmt.Sprintf("sorting: bucket sort key %v outside [0, 1)", k))
+		}
+		keys[i] = k
+		buckets[i] = min(int(float64(k)*float64(n)), n-1)
+		pos[buckets[i]+1]++
+	}
+	for b := 1; b <= n; b++ {
+		pos[b] += pos[b-1]
+	}
+
+	bounds := make([]int, n+1)
+	copy(bounds, pos)
+
+	buf := make(S, n)
+	kbuf := make([]F, n)
+	for i, b := range buckets {
+		buf[pos[b]] = s[i]
+		kbuf[pos[b]] = keys[i]
+		pos[b]++
+	}
+
+	for b := range n {
+		lo, hi := bounds[b], bounds[b+1]
+		for i := lo + 1; i < hi; i++ {
+			for j := i; j > lo && kbuf[j-1] > kbuf[j]; j-- {
+				buf[j-1], buf[j] = buf[j], buf[j-1]
+				kbuf[j-1], kbuf[j] = kbuf[j], kbuf[j-1]
+			}
+		}
+	}
+	copy(s, buf)
+}
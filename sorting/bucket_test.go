@@ -0,0 +1,62 @@
+package sorting
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestBucketSort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 10))
+	uniform := make([]float64, 1000)
+	for i := range uniform {
+		uniform[i] = rng.Float64()
+	}
+	clustered := make([]float64, 500)
+	for i := range clustered {
+		clustered[i] = 0.5 + float64(rng.IntN(10))/1000
+	}
+
+	cases := []struct {
+		name string
+		keys []float64
+	}{
+		{name: "empty arr", keys: []float64{}},
+		{name: "single element arr", keys: []float64{0.5}},
+		{name: "bounds arr", keys: []float64{0.999999, 0, 0.5, 0, 0.25}},
+		{name: "uniform arr", keys: uniform},
+		{name: "clustered arr", keys: clustered},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			input := toKeyed(tt.keys)
+			got := slices.Clone(input)
+			BucketSort(got, keyOf)
+			checkSortedStable(t, input, got, cmp.Compare[float64])
+		})
+	}
+}
+
+func TestBucketSortFloat32(t *testing.T) {
+	keys := []float32{0.75, 0.125, 0.5}
+	BucketSort(keys, func(k float32) float32 { return k })
+
+	if !slices.IsSorted(keys) {
+		t.Errorf("expected arr to be sorted but got: %v", keys)
+	}
+}
+
+func TestBucketSortOutOfRange(t *testing.T) {
+	for _, bad := range []float64{-0.1, 1, 2} {
+		func() {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Errorf("key %v: expected to panic but didn't", bad)
+				}
+			}()
+			BucketSort([]float64{0.5, bad}, func(k float64) float64 { return k })
+		}()
+	}
+}
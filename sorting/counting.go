@@ -0,0 +1,47 @@
+package sorting
+
+// maxSpanRatio bounds how many count slots CountingSort allocates per
+// element before it falls back to radix sort.
+const maxSpanRatio = 4
+
+// CountingSort sorts s by the integer keys returned by key by counting how
+// many elements have each key between the smallest and the largest. It is
+// stable and runs in O(n + k) time and memory, where k is the difference
+// between the largest and smallest key. When k is much larger than len(s) it
+// falls back to RadixSortLSD, which is also stable, instead of allocating a
+// count for every possible key.
+func CountingSort[S ~[]T, T any, K Integer](s S, key func(T) K) {
+	if len(s) < 2 {
+		return
+	}
+
+	keys := make([]uint64, len(s))
+	lo, hi := sortableBits(key(s[0])), sortableBits(key(s[0]))
+	for i, el := range s {
+		keys[i] = sortableBits(key(el))
+		lo = min(lo, keys[i])
+		hi = max(hi, keys[i])
+	}
+
+	// hi-lo cannot wrap since hi >= lo, but hi-lo+2 can for full-range keys.
+	span := hi - lo
+	if span > maxSpanRatio*uint64(len(s))+radixBuckets {
+		RadixSortLSD(s, key)
+		return
+	}
+
+	pos := make([]int, span+2)
+	for _, k := range keys {
+		pos[k-lo+1]++
+	}
+	for i := 1; i < len(pos); i++ {
+		pos[i] += pos[i-1]
+	}
+
+	buf := make(S, len(s))
+	for i, k := range keys {
+		buf[pos[k-lo]] = s[i]
+		pos[k-lo]++
+	}
+	copy(s, buf)
+}
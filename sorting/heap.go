@@ -0,0 +1,39 @@
+package sorting
+
+// HeapSort sorts s in place by building a max-heap and repeatedly moving its
+// root to the end. It is not stable and runs in O(n log n) in the worst case
+// with no extra memory.
+func HeapSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	heapSort(s, 0, len(s), comp)
+}
+
+// heapSort sorts s[lo:hi].
+func heapSort[S ~[]T, T any](s S, lo, hi int, comp func(T, T) int) {
+	h := s[lo:hi]
+	for i := len(h)/2 - 1; i >= 0; i-- {
+		siftDown(h, i, len(h), comp)
+	}
+
+	for end := len(h) - 1; end > 0; end-- {
+		h[0], h[end] = h[end], h[0]
+		siftDown(h, 0, end, comp)
+	}
+}
+
+// siftDown restores the max-heap property of h[:n] below root.
+func siftDown[S ~[]T, T any](h S, root, n int, comp func(T, T) int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && comp(h[child], h[child+1]) < 0 {
+			child++
+		}
+		if comp(h[root], h[child]) >= 0 {
+			return
+		}
+		h[root], h[child] = h[child], h[root]
+		root = child
+	}
+}
@@ -0,0 +1,18 @@
+package sorting
+
+// InsertionSort sorts s in place by growing a sorted prefix one element at a
+// time. It is stable and runs in O(n) on already sorted input, O(n²)
+// otherwise.
+func InsertionSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	insertionSort(s, 0, len(s), comp)
+}
+
+// insertionSort sorts s[lo:hi]. The other algorithms use it to finish off
+// small ranges.
+func insertionSort[S ~[]T, T any](s S, lo, hi int, comp func(T, T) int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && comp(s[j-1], s[j]) > 0; j-- {
+			s[j-1], s[j] = s[j], s[j-1]
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package sorting
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Op is the kind of a traced Step.
+type Op string
+
+const (
+	// OpCompare is a call to the comparator with the elements at I and J.
+	OpCompare Op = "compare"
+	// OpSwap is an exchange of the elements at I and J.
+	OpSwap Op = "swap"
+	// OpWrite is a move of the element at J into position I.
+	OpWrite Op = "write"
+)
+
+// Step is one traced operation of an instrumented sort. Indices refer to
+// positions in the slice being sorted, or are -1 for an element the sort is
+// holding in a scratch buffer of its own.
+type Step struct {
+	Op Op  `json:"op"`
+	I  int `json:"i"`
+	J  int `json:"j"`
+	// Result is the comparator's return value for OpCompare steps.
+	Result int `json:"result"`
+}
+
+// Stats counts the work an instrumented sort did.
+type Stats struct {
+	Comparisons int `json:"comparisons"`
+	// Swaps counts pairs of positions that exchanged elements.
+	Swaps int `json:"swaps"`
+	// Writes counts every position whose element changed, including the
+	// two positions of each swap.
+	Writes int `json:"writes"`
+}
+
+// Item is the element type an instrumented sort actually sorts. It tags each
+// value with its original position so moves can be told apart from
+// overwrites with an equal value.
+type Item[T any] struct {
+	Value T
+	id    int
+}
+
+// Instrument sorts s with alg and returns how many comparisons, swaps and
+// writes it took. If trace is not nil it is called with every step in the
+// order they happened.
+func Instrument[S ~[]T, T any](alg Algorithm, s S, comp func(T, T) int, trace func(Step)) Stats {
+	return InstrumentFunc(func(items []Item[T], comp func(Item[T], Item[T]) int) {
+		Sort(alg, items, comp)
+	}, s, comp, trace)
+}
+
+// InstrumentFunc is Instrument for any sort with this package's signature,
+// such as SelectionSort[[]Item[T]] or bubblesort.BubbleSort[[]Item[T]].
+//
+// Moves are detected by diffing the slice before every comparison, so
+// instrumenting costs O(n) per comparison and is meant for the input sizes
+// used in teaching and visualization. Moves between two comparisons are
+// reported as their net effect: a swap when two positions exchanged
+// elements, writes otherwise. Applying the moves after each comparison to
+// the slice as it was at that comparison reproduces the sort, except for
+// writes from scratch buffers, whose source index is -1.
+func InstrumentFunc[S ~[]T, T any](sort func([]Item[T], func(Item[T], Item[T]) int), s S, comp func(T, T) int, trace func(Step)) Stats {
+	in := &instrument[T]{
+		items: make([]Item[T], len(s)),
+		ids:   make([]int, len(s)),
+		pos:   make([]int, len(s)),
+		trace: trace,
+	}
+	for i, val := range s {
+		in.items[i] = Item[T]{Value: val, id: i}
+		in.ids[i] = i
+		in.pos[i] = i
+	}
+
+	sort(in.items, func(a, b Item[T]) int {
+		in.sync()
+		res := comp(a.Value, b.Value)
+		in.stats.Comparisons++
+		in.emit(Step{Op: OpCompare, I: in.position(a.id), J: in.position(b.id), Result: res})
+		return res
+	})
+	in.sync()
+
+	for i, item := range in.items {
+		s[i] = item.Value
+	}
+	return in.stats
+}
+
+type instrument[T any] struct {
+	items []Item[T]
+	// ids is the id at every position as of the last sync and pos is the
+	// inverse, the position every id was last seen at.
+	ids, pos []int
+	changed  []int
+	stats    Stats
+	trace    func(Step)
+}
+
+// sync reports every position that changed since the last call.
+func (in *instrument[T]) sync() {
+	in.changed = in.changed[:0]
+	for i, item := range in.items {
+		if item.id != in.ids[i] {
+			in.changed = append(in.changed, i)
+		}
+	}
+
+	for _, i := range in.changed {
+		from := in.position(in.items[i].id)
+		isSwap := from >= 0 && in.items[from].id == in.ids[i] && in.ids[from] != in.ids[i]
+		switch {
+		case isSwap && i < from:
+			in.stats.Swaps++
+			in.emit(Step{Op: OpSwap, I: i, J: from})
+		case isSwap:
+			// Reported with its partner.
+		default:
+			in.emit(Step{Op: OpWrite, I: i, J: from})
+		}
+		in.stats.Writes++
+	}
+
+	for _, i := range in.changed {
+		in.ids[i] = in.items[i].id
+		in.pos[in.ids[i]] = i
+	}
+}
+
+// position returns where id was as of the last sync, or -1 if it was only
+// in a scratch buffer.
+func (in *instrument[T]) position(id int) int {
+	if p := in.pos[id]; in.ids[p] == id {
+		return p
+	}
+	return -1
+}
+
+func (in *instrument[T]) emit(step Step) {
+	if in.trace != nil {
+		in.trace(step)
+	}
+}
+
+// JSONLinesTracer writes each Step it is given to w as one line of JSON.
+type JSONLinesTracer struct {
+	enc *json.Encoder
+	err error
+}
+
+func NewJSONLinesTracer(w io.Writer) *JSONLinesTracer {
+	return &JSONLinesTracer{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// Trace writes step. It can be passed to Instrument as the trace callback.
+// After the first failed write it does nothing.
+func (t *JSONLinesTracer) Trace(step Step) {
+	if t.err != nil {
+		return
+	}
+	t.err = t.enc.Encode(step)
+}
+
+// Err returns the first error encountered while writing.
+func (t *JSONLinesTracer) Err() error {
+	return t.err
+}
@@ -0,0 +1,214 @@
+package sorting
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	bubblesort "github.com/zukofett/go_algo/bubble_sort"
+)
+
+// replay applies the moves in steps to s. Moves between two comparisons are
+// relative to the slice as it was at the earlier comparison. It reports false
+// if the trace has writes from a scratch buffer, which cannot be replayed.
+func replay(s []int, steps []Step) ([]int, bool) {
+	cur := slices.Clone(s)
+	snap := slices.Clone(s)
+	for _, step := range steps {
+		switch step.Op {
+		case OpCompare:
+			copy(snap, cur)
+		case OpSwap:
+			cur[step.I], cur[step.J] = snap[step.J], snap[step.I]
+		case OpWrite:
+			if step.J < 0 {
+				return nil, false
+			}
+			cur[step.I] = snap[step.J]
+		}
+	}
+	return cur, true
+}
+
+// inPlace lists the algorithms that never hold elements outside the slice.
+var inPlace = []Algorithm{Insertion, Selection, Shell, Quick, Heap, InPlaceMerge, Intro, Pdq}
+
+func TestInstrument(t *testing.T) {
+	for _, alg := range Algorithms {
+		for _, tt := range testInputs(500) {
+			t.Run(alg.String()+"/"+tt.name, func(t *testing.T) {
+				input := slices.Clone(tt.arr)
+				want := slices.Clone(tt.arr)
+				comparisons := 0
+				Sort(alg, want, func(a, b int) int {
+					comparisons++
+					return cmp.Compare(a, b)
+				})
+
+				var steps []Step
+				stats := Instrument(alg, tt.arr, cmp.Compare[int], func(step Step) {
+					steps = append(steps, step)
+				})
+
+				if !slices.Equal(tt.arr, want) {
+					t.Errorf("got %v; want %v", tt.arr, want)
+				}
+				if stats.Comparisons != comparisons {
+					t.Errorf("got %d comparisons; want %d", stats.Comparisons, comparisons)
+				}
+
+				var counted Stats
+				for _, step := range steps {
+					switch step.Op {
+					case OpCompare:
+						counted.Comparisons++
+					case OpSwap:
+						counted.Swaps++
+						counted.Writes += 2
+					case OpWrite:
+						counted.Writes++
+					}
+				}
+				if counted != stats {
+					t.Errorf("trace adds up to %+v; stats are %+v", counted, stats)
+				}
+
+				got, ok := replay(input, steps)
+				if ok && !slices.Equal(got, want) {
+					t.Errorf("replaying the trace gives %v; want %v", got, want)
+				}
+				if !ok && slices.Contains(inPlace, alg) {
+					t.Errorf("in-place sort wrote from outside the slice")
+				}
+			})
+		}
+	}
+}
+
+func TestInstrumentFunc(t *testing.T) {
+	s := []int{3, 2, 1}
+	var steps []Step
+	stats := InstrumentFunc(bubblesort.BubbleSort[[]Item[int]], s, cmp.Compare[int], func(step Step) {
+		steps = append(steps, step)
+	})
+
+	if want := []int{1, 2, 3}; !slices.Equal(s, want) {
+		t.Errorf("got %v; want %v", s, want)
+	}
+	if want := (Stats{Comparisons: 3, Swaps: 3, Writes: 6}); stats != want {
+		t.Errorf("got %+v; want %+v", stats, want)
+	}
+
+	want := []Step{
+		{Op: OpCompare, I: 0, J: 1, Result: 1},
+		{Op: OpSwap, I: 0, J: 1},
+		{Op: OpCompare, I: 1, J: 2, Result: 1},
+		{Op: OpSwap, I: 1, J: 2},
+		{Op: OpCompare, I: 0, J: 1, Result: 1},
+		{Op: OpSwap, I: 0, J: 1},
+	}
+	if !slices.Equal(steps, want) {
+		t.Errorf("got trace %v; want %v", steps, want)
+	}
+}
+
+func TestInstrumentScratchBuffer(t *testing.T) {
+	s := []int{4, 3, 2, 1}
+	fromBuffer := 0
+	Instrument(Merge, s, cmp.Compare[int], func(step Step) {
+		if step.Op == OpWrite && step.J == -1 {
+			fromBuffer++
+		}
+	})
+
+	if want := []int{1, 2, 3, 4}; !slices.Equal(s, want) {
+		t.Errorf("got %v; want %v", s, want)
+	}
+	if fromBuffer == 0 {
+		t.Error("expected writes from the merge buffer")
+	}
+}
+
+func TestInstrumentNilTrace(t *testing.T) {
+	s := []int{5, 1, 4, 2, 3}
+	stats := Instrument(Insertion, s, cmp.Compare[int], nil)
+
+	if want := []int{1, 2, 3, 4, 5}; !slices.Equal(s, want) {
+		t.Errorf("got %v; want %v", s, want)
+	}
+	if stats.Comparisons == 0 || stats.Writes == 0 {
+		t.Errorf("got %+v; want non-zero counts", stats)
+	}
+}
+
+func TestJSONLinesTracer(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := NewJSONLinesTracer(&buf)
+
+	var steps []Step
+	Instrument(Quick, []int{4, 1, 3, 2, 4, 0}, cmp.Compare[int], func(step Step) {
+		steps = append(steps, step)
+		tracer.Trace(step)
+	})
+	if err := tracer.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []Step
+	equal := 0
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var step Step
+		if err := json.Unmarshal(scanner.Bytes(), &step); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		got = append(got, step)
+
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &fields); err != nil {
+			t.Fatalf("line %q is not a JSON object: %v", scanner.Text(), err)
+		}
+		if _, ok := fields["result"]; !ok && step.Op == OpCompare {
+			t.Errorf("line %q has no result field", scanner.Text())
+		}
+		if step.Op == OpCompare && step.Result == 0 {
+			equal++
+		}
+	}
+	if equal == 0 {
+		t.Error("expected a compare of equal elements in the trace")
+	}
+
+	if !slices.Equal(got, steps) {
+		t.Errorf("got %v; want %v", got, steps)
+	}
+}
+
+type failingWriter struct {
+	writes int
+}
+
+var errWrite = errors.New("write failed")
+
+func (w *failingWriter) Write([]byte) (int, error) {
+	w.writes++
+	return 0, errWrite
+}
+
+func TestJSONLinesTracerError(t *testing.T) {
+	w := &failingWriter{}
+	tracer := NewJSONLinesTracer(w)
+
+	Instrument(Selection, []int{3, 2, 1}, cmp.Compare[int], tracer.Trace)
+
+	if err := tracer.Err(); !errors.Is(err, errWrite) {
+		t.Errorf("got error %v; want %v", err, errWrite)
+	}
+	if w.writes != 1 {
+		t.Errorf("got %d writes; want 1", w.writes)
+	}
+}
@@ -0,0 +1,30 @@
+package sorting
+
+import "math/bits"
+
+// IntroSort sorts s in place with the same quicksort as QuickSort, but
+// switches a range to heapsort once the recursion gets deeper than
+// 2*log2(n). That caps the worst case at O(n log n). It is not stable.
+func IntroSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	introSort(s, 0, len(s), 2*bits.Len(uint(len(s))), comp)
+}
+
+func introSort[S ~[]T, T any](s S, lo, hi, depth int, comp func(T, T) int) {
+	for hi-lo > insertionThreshold {
+		if depth == 0 {
+			heapSort(s, lo, hi, comp)
+			return
+		}
+		depth--
+
+		lt, gt := partition3(s, lo, hi, medianOfThree(s, lo, lo+(hi-lo)/2, hi-1, comp), comp)
+		if lt-lo < hi-gt {
+			introSort(s, lo, lt, depth, comp)
+			lo = gt
+		} else {
+			introSort(s, gt, hi, depth, comp)
+			hi = lt
+		}
+	}
+	insertionSort(s, lo, hi, comp)
+}
@@ -0,0 +1,74 @@
+package sorting
+
+// MergeSort sorts s with a recursive top-down merge sort. It is stable, runs
+// in O(n log n) and allocates one buffer the size of s.
+func MergeSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	buf := make(S, len(s))
+	mergeSort(s, buf, comp)
+}
+
+// mergeSort sorts s using buf, which must be the same length, as scratch
+// space.
+func mergeSort[S ~[]T, T any](s, buf S, comp func(T, T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	mid := len(s) / 2
+	mergeSort(s[:mid], buf[:mid], comp)
+	mergeSort(s[mid:], buf[mid:], comp)
+
+	if comp(s[mid-1], s[mid]) <= 0 {
+		return
+	}
+
+	copy(buf, s)
+	merge(s, buf[:mid], buf[mid:], comp)
+}
+
+// MergeSortBottomUp sorts s with an iterative merge sort that merges runs of
+// width 1, 2, 4, ... until one run is left. It is stable and runs in
+// O(n log n) without recursion.
+func MergeSortBottomUp[S ~[]T, T any](s S, comp func(T, T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	src, dst := s, make(S, len(s))
+	for width := 1; width < len(s); width *= 2 {
+		for lo := 0; lo < len(s); lo += 2 * width {
+			mid := min(lo+width, len(s))
+			hi := min(lo+2*width, len(s))
+			merge(dst[lo:hi], src[lo:mid], src[mid:hi], comp)
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// merge merges the sorted runs left and right into dst. Ties are taken from
+// left, which keeps the merge stable. dst must not overlap left, but it may
+// overlap right if right is its tail, since every write then lands on an
+// element of right that has already been consumed.
+func merge[S ~[]T, T any](dst, left, right S, comp func(T, T) int) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if comp(left[i], right[j]) <= 0 {
+			dst[k] = left[i]
+			i++
+		} else {
+			dst[k] = right[j]
+			j++
+		}
+		k++
+	}
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
@@ -0,0 +1,148 @@
+package sorting
+
+import (
+	"context"
+	"math/bits"
+	"runtime"
+	"sync"
+)
+
+// defaultGrain is the range size below which the parallel sorts stop
+// forking when ParallelOptions.Grain is not set.
+const defaultGrain = 1 << 12
+
+// ParallelOptions tunes the parallel sorts. The zero value picks sensible
+// defaults.
+type ParallelOptions struct {
+	// Grain is the size below which a range is sorted sequentially instead
+	// of being split between goroutines. Defaults to 4096.
+	Grain int
+	// Workers caps how many goroutines sort at the same time, including the
+	// caller's. Defaults to runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// ParallelSort sorts s with a merge sort that sorts both halves of every
+// range larger than opts.Grain concurrently. Its output is identical to
+// MergeSort, so it is stable.
+//
+// If ctx is cancelled the sort stops early and returns ctx.Err(). s then
+// still holds the same elements, in an unspecified order.
+func ParallelSort[S ~[]T, T any](ctx context.Context, s S, comp func(T, T) int, opts ParallelOptions) error {
+	p := newParallel[S](ctx, comp, opts)
+	if len(s) >= 2 {
+		p.mergeSort(s, make(S, len(s)))
+	}
+	return ctx.Err()
+}
+
+// ParallelQuickSort sorts s with the introsort from IntroSort, sorting both
+// sides of every partition larger than opts.Grain concurrently. It needs no
+// extra memory but is not stable.
+//
+// If ctx is cancelled the sort stops early and returns ctx.Err(). s then
+// still holds the same elements, in an unspecified order.
+func ParallelQuickSort[S ~[]T, T any](ctx context.Context, s S, comp func(T, T) int, opts ParallelOptions) error {
+	p := newParallel[S](ctx, comp, opts)
+	p.quickSort(s, 0, len(s), 2*bits.Len(uint(len(s))))
+	return ctx.Err()
+}
+
+type parallel[S ~[]T, T any] struct {
+	ctx   context.Context
+	comp  func(T, T) int
+	grain int
+	// sem holds a token for every goroutine besides the caller's that may
+	// run at the same time.
+	sem chan struct{}
+}
+
+func newParallel[S ~[]T, T any](ctx context.Context, comp func(T, T) int, opts ParallelOptions) *parallel[S, T] {
+	if opts.Grain < 2 {
+		opts.Grain = defaultGrain
+	}
+	if opts.Workers < 1 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	return &parallel[S, T]{
+		ctx:   ctx,
+		comp:  comp,
+		grain: opts.Grain,
+		sem:   make(chan struct{}, opts.Workers-1),
+	}
+}
+
+// fork runs a and b and returns once both are done. a gets its own goroutine
+// if a worker is free; otherwise both run on the calling goroutine, so the
+// pool never blocks waiting for itself.
+func (p *parallel[S, T]) fork(a, b func()) {
+	select {
+	case p.sem <- struct{}{}:
+	default:
+		a()
+		b()
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer func() {
+			<-p.sem
+			wg.Done()
+		}()
+		a()
+	}()
+	b()
+	wg.Wait()
+}
+
+func (p *parallel[S, T]) cancelled() bool {
+	return p.ctx.Err() != nil
+}
+
+func (p *parallel[S, T]) mergeSort(s, buf S) {
+	if p.cancelled() {
+		return
+	}
+	if len(s) <= p.grain {
+		mergeSort(s, buf, p.comp)
+		return
+	}
+
+	mid := len(s) / 2
+	p.fork(func() {
+		p.mergeSort(s[:mid], buf[:mid])
+	}, func() {
+		p.mergeSort(s[mid:], buf[mid:])
+	})
+
+	if p.cancelled() || p.comp(s[mid-1], s[mid]) <= 0 {
+		return
+	}
+
+	copy(buf, s)
+	merge(s, buf[:mid], buf[mid:], p.comp)
+}
+
+func (p *parallel[S, T]) quickSort(s S, lo, hi, depth int) {
+	if p.cancelled() {
+		return
+	}
+	if hi-lo <= p.grain {
+		introSort(s, lo, hi, depth, p.comp)
+		return
+	}
+	if depth == 0 {
+		heapSort(s, lo, hi, p.comp)
+		return
+	}
+
+	lt, gt := partition3(s, lo, hi, medianOfThree(s, lo, lo+(hi-lo)/2, hi-1, p.comp), p.comp)
+	p.fork(func() {
+		p.quickSort(s, lo, lt, depth-1)
+	}, func() {
+		p.quickSort(s, gt, hi, depth-1)
+	})
+}
@@ -0,0 +1,157 @@
+package sorting
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func parallelInput(n int) []record {
+	rng := rand.New(rand.NewPCG(11, 12))
+	s := make([]record, n)
+	for i := range s {
+		s[i] = record{key: rng.IntN(n / 4), seq: i}
+	}
+	return s
+}
+
+func TestParallelSort(t *testing.T) {
+	cases := []struct {
+		name string
+		n    int
+		opts ParallelOptions
+	}{
+		{name: "defaults", n: 50_000, opts: ParallelOptions{}},
+		{name: "small grain", n: 10_000, opts: ParallelOptions{Grain: 16, Workers: 8}},
+		{name: "single worker", n: 10_000, opts: ParallelOptions{Grain: 64, Workers: 1}},
+		{name: "smaller than grain", n: 100, opts: ParallelOptions{Grain: 1000}},
+		{name: "empty", n: 0, opts: ParallelOptions{}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			input := parallelInput(tt.n)
+
+			want := slices.Clone(input)
+			MergeSort(want, compRecords)
+
+			got := slices.Clone(input)
+			if err := ParallelSort(context.Background(), got, compRecords, tt.opts); err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if !slices.Equal(got, want) {
+				t.Fatal("parallel merge sort output differs from MergeSort")
+			}
+
+			quick := slices.Clone(input)
+			if err := ParallelQuickSort(context.Background(), quick, compRecords, tt.opts); err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if !slices.IsSortedFunc(quick, compRecords) {
+				t.Fatal("expected parallel quicksort output to be sorted")
+			}
+		})
+	}
+}
+
+func TestParallelSortAdversarial(t *testing.T) {
+	for _, tt := range testInputs(1<<14, adversarialShapes...) {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ParallelQuickSort(context.Background(), tt.arr, cmp.Compare[int], ParallelOptions{Grain: 256})
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if !slices.IsSorted(tt.arr) {
+				t.Fatal("expected arr to be sorted")
+			}
+		})
+	}
+}
+
+func TestParallelSortCancelled(t *testing.T) {
+	sorts := map[string]func(context.Context, []record, func(record, record) int, ParallelOptions) error{
+		"merge": ParallelSort[[]record, record],
+		"quick": ParallelQuickSort[[]record, record],
+	}
+
+	for name, sort := range sorts {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			input := parallelInput(20_000)
+			got := slices.Clone(input)
+			if err := sort(ctx, got, compRecords, ParallelOptions{Grain: 64}); err != context.Canceled {
+				t.Fatalf("got error %v; want %v", err, context.Canceled)
+			}
+
+			bySeq := func(a, b record) int { return cmp.Compare(a.seq, b.seq) }
+			slices.SortFunc(got, bySeq)
+			if !slices.Equal(got, input) {
+				t.Fatal("cancelled sort lost or duplicated elements")
+			}
+		})
+	}
+}
+
+func TestParallelSortCancelledMidway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A single worker keeps every comparison on this goroutine, so the
+	// counter needs no locking.
+	comparisons := 0
+	comp := func(a, b record) int {
+		if comparisons++; comparisons == 1000 {
+			cancel()
+		}
+		return compRecords(a, b)
+	}
+
+	input := parallelInput(100_000)
+	got := slices.Clone(input)
+	if err := ParallelSort(ctx, got, comp, ParallelOptions{Grain: 128, Workers: 1}); err != context.Canceled {
+		t.Fatalf("got error %v; want %v", err, context.Canceled)
+	}
+
+	slices.SortFunc(got, func(a, b record) int { return cmp.Compare(a.seq, b.seq) })
+	if !slices.Equal(got, input) {
+		t.Fatal("cancelled sort lost or duplicated elements")
+	}
+}
+
+func BenchmarkParallelSort(b *testing.B) {
+	const n = 1 << 20
+	rng := rand.New(rand.NewPCG(1, 2))
+	input := make([]int, n)
+	for i := range input {
+		input[i] = rng.Int()
+	}
+
+	sorts := []struct {
+		name string
+		sort func([]int)
+	}{
+		{name: "merge", sort: func(s []int) { MergeSort(s, cmp.Compare[int]) }},
+		{name: "parallel merge", sort: func(s []int) {
+			ParallelSort(context.Background(), s, cmp.Compare[int], ParallelOptions{})
+		}},
+		{name: "intro", sort: func(s []int) { IntroSort(s, cmp.Compare[int]) }},
+		{name: "parallel quick", sort: func(s []int) {
+			ParallelQuickSort(context.Background(), s, cmp.Compare[int], ParallelOptions{})
+		}},
+	}
+
+	for _, alg := range sorts {
+		b.Run(fmt.Sprintf("%s/%d", alg.name, n), func(b *testing.B) {
+			arr := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				alg.sort(arr)
+			}
+		})
+	}
+}
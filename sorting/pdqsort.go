@@ -0,0 +1,263 @@
+package sorting
+
+import "math/bits"
+
+// PdqSort sorts s in place with pattern-defeating quicksort, the algorithm
+// by Orson Peters that also backs slices.SortFunc. On top of introsort it
+// detects sorted and reversed ranges, partitions runs of elements equal to
+// an earlier pivot in one pass, and shuffles a few elements when a partition
+// comes out unbalanced. It is not stable and runs in O(n log n) in the worst
+// case, O(n) on sorted, reversed or mostly equal input.
+func PdqSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	pdqSort(s, 0, len(s), bits.Len(uint(len(s))), comp)
+}
+
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// pdqSort sorts s[a:b]. limit is the number of unbalanced partitions allowed
+// before falling back to heapsort.
+func pdqSort[S ~[]T, T any](s S, a, b, limit int, comp func(T, T) int) {
+	wasBalanced := true
+	wasPartitioned := true
+
+	for {
+		length := b - a
+
+		if length <= insertionThreshold {
+			insertionSort(s, a, b, comp)
+			return
+		}
+
+		if limit == 0 {
+			heapSort(s, a, b, comp)
+			return
+		}
+
+		if !wasBalanced {
+			breakPatterns(s, a, b)
+			limit--
+		}
+
+		pivot, hint := choosePivot(s, a, b, comp)
+		if hint == decreasingHint {
+			reverse(s[a:b])
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+
+		// The range looks sorted and the last partition did not move
+		// anything, so try to finish it with a bounded insertion sort.
+		if wasBalanced && wasPartitioned && hint == increasingHint {
+			if partialInsertionSort(s, a, b, comp) {
+				return
+			}
+		}
+
+		// s[a-1] was the pivot of the parent partition, so everything in
+		// s[a:b] is at least as big. If the new pivot equals it, split off
+		// every element equal to the pivot and skip them.
+		if a > 0 && comp(s[a-1], s[pivot]) >= 0 {
+			a = partitionEqual(s, a, b, pivot, comp)
+			continue
+		}
+
+		mid, alreadyPartitioned := partition(s, a, b, pivot, comp)
+		wasPartitioned = alreadyPartitioned
+
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			pdqSort(s, a, mid, limit, comp)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			pdqSort(s, mid+1, b, limit, comp)
+			b = mid
+		}
+	}
+}
+
+// partition moves the pivot to its final index in s[a:b] with everything
+// smaller before it, and reports whether the range was already partitioned.
+func partition[S ~[]T, T any](s S, a, b, pivot int, comp func(T, T) int) (int, bool) {
+	s[a], s[pivot] = s[pivot], s[a]
+	i, j := a+1, b-1
+
+	for i <= j && comp(s[i], s[a]) < 0 {
+		i++
+	}
+	for i <= j && comp(s[j], s[a]) >= 0 {
+		j--
+	}
+	if i > j {
+		s[j], s[a] = s[a], s[j]
+		return j, true
+	}
+	s[i], s[j] = s[j], s[i]
+	i++
+	j--
+
+	for {
+		for i <= j && comp(s[i], s[a]) < 0 {
+			i++
+		}
+		for i <= j && comp(s[j], s[a]) >= 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+	s[j], s[a] = s[a], s[j]
+	return j, false
+}
+
+// partitionEqual moves every element of s[a:b] equal to the pivot to the
+// front, given that none is smaller, and returns the index after them.
+func partitionEqual[S ~[]T, T any](s S, a, b, pivot int, comp func(T, T) int) int {
+	s[a], s[pivot] = s[pivot], s[a]
+	i, j := a+1, b-1
+
+	for {
+		for i <= j && comp(s[a], s[i]) >= 0 {
+			i++
+		}
+		for i <= j && comp(s[a], s[j]) < 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort fixes up to a handful of out of order elements in
+// s[a:b] and reports whether that left the range sorted.
+func partialInsertionSort[S ~[]T, T any](s S, a, b int, comp func(T, T) int) bool {
+	const (
+		maxSteps         = 5
+		shortestShifting = 50
+	)
+
+	i := a + 1
+	for range maxSteps {
+		for i < b && comp(s[i], s[i-1]) >= 0 {
+			i++
+		}
+
+		if i == b {
+			return true
+		}
+
+		if b-a < shortestShifting {
+			return false
+		}
+
+		s[i], s[i-1] = s[i-1], s[i]
+
+		// Shift the smaller element left and the bigger one right.
+		for j := i - 1; j > a && comp(s[j], s[j-1]) < 0; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+		for j := i + 1; j < b && comp(s[j], s[j-1]) < 0; j++ {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps a few elements around the middle of s[a:b] with
+// pseudo-random ones so that an input crafted against the pivot selection
+// cannot keep producing unbalanced partitions.
+func breakPatterns[S ~[]T, T any](s S, a, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+
+	random := xorshift(length)
+	modulus := uint(1) << bits.Len(uint(length))
+
+	idx := a + (length/4)*2 - 1
+	for i := range 3 {
+		other := int(uint(random.next()) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		s[idx-1+i], s[a+other] = s[a+other], s[idx-1+i]
+	}
+}
+
+type xorshift uint64
+
+func (r *xorshift) next() uint64 {
+	*r ^= *r << 13
+	*r ^= *r >> 7
+	*r ^= *r << 17
+	return uint64(*r)
+}
+
+// choosePivot picks the median of three elements, or of three medians of
+// three (Tukey's ninther) on longer ranges, and uses the number of swaps the
+// medians needed to guess whether the range is sorted.
+func choosePivot[S ~[]T, T any](s S, a, b int, comp func(T, T) int) (int, sortedHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+
+	l := b - a
+	swaps := 0
+	i := a + l/4*1
+	j := a + l/4*2
+	k := a + l/4*3
+
+	if l >= 8 {
+		if l >= shortestNinther {
+			i = pivotMedian(s, i-1, i, i+1, &swaps, comp)
+			j = pivotMedian(s, j-1, j, j+1, &swaps, comp)
+			k = pivotMedian(s, k-1, k, k+1, &swaps, comp)
+		}
+		j = pivotMedian(s, i, j, k, &swaps, comp)
+	}
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// pivotMedian returns the index of the median of s[a], s[b] and s[c],
+// counting how many of its comparisons found a pair out of order.
+func pivotMedian[S ~[]T, T any](s S, a, b, c int, swaps *int, comp func(T, T) int) int {
+	order := func(x, y int) (int, int) {
+		if comp(s[y], s[x]) < 0 {
+			*swaps++
+			return y, x
+		}
+		return x, y
+	}
+
+	a, b = order(a, b)
+	b, c = order(b, c)
+	_, b = order(a, b)
+	return b
+}
@@ -0,0 +1,112 @@
+package sorting
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"slices"
+	"testing"
+)
+
+// medianOfThreeKiller builds Musser's sequence that drives a quicksort using
+// the median of the first, middle and last element into quadratic time.
+func medianOfThreeKiller(n int) []int {
+	k := n / 2
+	s := make([]int, 2*k)
+	for i := 1; i <= k; i++ {
+		if i%2 == 1 {
+			s[i-1] = i
+			s[i] = k + i
+		}
+		s[k+i-1] = 2 * i
+	}
+	return s
+}
+
+// oneMisplaced is sorted apart from a maximum moved to the front.
+func oneMisplaced(n int) []int {
+	s := sequence(n, func(i int) int { return i })
+	if n > 0 {
+		s[0] = n
+	}
+	return s
+}
+
+// adversarialShapes are added to testInputs for the worst-case tests.
+var adversarialShapes = []shape{
+	{name: "median-of-3 killer", gen: medianOfThreeKiller},
+	{name: "sorted with one misplaced", gen: oneMisplaced},
+}
+
+func TestWorstCaseGuarantee(t *testing.T) {
+	const n = 1 << 12
+	limit := 4 * n * bits.Len(n)
+
+	for _, alg := range []Algorithm{Intro, Pdq} {
+		for _, tt := range testInputs(n, adversarialShapes...) {
+			t.Run(alg.String()+"/"+tt.name, func(t *testing.T) {
+				comparisons := 0
+				Sort(alg, tt.arr, func(a, b int) int {
+					comparisons++
+					return cmp.Compare(a, b)
+				})
+
+				if !slices.IsSorted(tt.arr) {
+					t.Fatalf("expected arr to be sorted")
+				}
+				if comparisons > limit {
+					t.Errorf("got %d comparisons; want at most %d", comparisons, limit)
+				}
+			})
+		}
+	}
+}
+
+func TestPdqSortLinearOnPatterns(t *testing.T) {
+	const n = 1 << 12
+
+	linear := map[string]bool{
+		"sorted arr":                    true,
+		"reversed arr":                  true,
+		"all equal arr":                 true,
+		"sorted with one misplaced arr": true,
+	}
+
+	for _, tt := range testInputs(n, adversarialShapes...) {
+		if !linear[tt.name] {
+			continue
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			comparisons := 0
+			PdqSort(tt.arr, func(a, b int) int {
+				comparisons++
+				return cmp.Compare(a, b)
+			})
+
+			if comparisons > 4*n {
+				t.Errorf("got %d comparisons; want at most %d", comparisons, 4*n)
+			}
+		})
+	}
+}
+
+func BenchmarkAdversarial(b *testing.B) {
+	const n = 1 << 14
+
+	for _, tt := range testInputs(n, adversarialShapes...) {
+		if len(tt.arr) != n {
+			continue
+		}
+
+		for _, alg := range []Algorithm{Quick, Heap, Intro, Pdq} {
+			b.Run(fmt.Sprintf("%s/%s", tt.name, alg), func(b *testing.B) {
+				arr := make([]int, n)
+				for i := 0; i < b.N; i++ {
+					copy(arr, tt.arr)
+					Sort(alg, arr, cmp.Compare[int])
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package sorting
+
+// insertionThreshold is the range size below which the divide and conquer
+// sorts fall back to insertion sort.
+const insertionThreshold = 12
+
+// QuickSort sorts s in place with a quicksort that picks the median of the
+// first, middle and last element as pivot and partitions three ways, so runs
+// of equal elements are handled in linear time. It is not stable and runs in
+// O(n log n) on average, O(n²) in the worst case.
+func QuickSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	quickSort(s, 0, len(s), comp)
+}
+
+func quickSort[S ~[]T, T any](s S, lo, hi int, comp func(T, T) int) {
+	for hi-lo > insertionThreshold {
+		lt, gt := partition3(s, lo, hi, medianOfThree(s, lo, lo+(hi-lo)/2, hi-1, comp), comp)
+
+		// Recurse into the smaller side and loop on the larger one to keep
+		// the stack depth at O(log n).
+		if lt-lo < hi-gt {
+			quickSort(s, lo, lt, comp)
+			lo = gt
+		} else {
+			quickSort(s, gt, hi, comp)
+			hi = lt
+		}
+	}
+	insertionSort(s, lo, hi, comp)
+}
+
+// medianOfThree orders the elements at a, b and c among themselves so that
+// s[a] <= s[b] <= s[c] and returns b. Leaving the samples ordered, rather
+// than only picking the median, keeps sorted and reversed ranges close to
+// sorted after partitioning.
+func medianOfThree[S ~[]T, T any](s S, a, b, c int, comp func(T, T) int) int {
+	if comp(s[b], s[a]) < 0 {
+		s[a], s[b] = s[b], s[a]
+	}
+	if comp(s[c], s[b]) < 0 {
+		s[b], s[c] = s[c], s[b]
+		if comp(s[b], s[a]) < 0 {
+			s[a], s[b] = s[b], s[a]
+		}
+	}
+	return b
+}
+
+// partition3 rearranges s[lo:hi] around the element at pivot into
+// s[lo:lt] < pivot, s[lt:gt] == pivot and s[gt:hi] > pivot, and returns lt
+// and gt. It uses Bentley and McIlroy's scheme: a Hoare-style scan from both
+// ends that parks equal elements at the ends and swaps them into the middle
+// at the end, so input that is already partitioned is left untouched.
+func partition3[S ~[]T, T any](s S, lo, hi, pivot int, comp func(T, T) int) (int, int) {
+	s[lo], s[pivot] = s[pivot], s[lo]
+	p := s[lo]
+
+	i, j := lo, hi
+	eqLo, eqHi := lo, hi
+	for {
+		for i++; comp(s[i], p) < 0 && i != hi-1; i++ {
+		}
+		for j--; comp(p, s[j]) < 0 && j != lo; j-- {
+		}
+
+		if i == j && comp(s[i], p) == 0 {
+			eqLo++
+			s[eqLo], s[i] = s[i], s[eqLo]
+		}
+		if i >= j {
+			break
+		}
+
+		s[i], s[j] = s[j], s[i]
+		if comp(s[i], p) == 0 {
+			eqLo++
+			s[eqLo], s[i] = s[i], s[eqLo]
+		}
+		if comp(s[j], p) == 0 {
+			eqHi--
+			s[eqHi], s[j] = s[j], s[eqHi]
+		}
+	}
+
+	i = j + 1
+	for k := lo; k <= eqLo; k++ {
+		s[k], s[j] = s[j], s[k]
+		j--
+	}
+	for k := hi - 1; k >= eqHi; k-- {
+		s[k], s[i] = s[i], s[k]
+		i++
+	}
+	return j + 1, i
+}
@@ -0,0 +1,188 @@
+package sorting
+
+// Integer is the set of key types the integer radix and counting sorts
+// accept.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// ByteString is the set of key types the string radix sorts accept. Keys are
+// ordered byte by byte, the same as strings.Compare and bytes.Compare.
+type ByteString interface {
+	~string | ~[]byte
+}
+
+// radixBuckets is one bucket per byte value plus bucket 0 for keys that have
+// no byte at the current position.
+const radixBuckets = 257
+
+// sortableBits maps an integer key to a uint64 with the same ordering. Signed
+// keys are sign extended and have their sign bit flipped so negative numbers
+// come first.
+func sortableBits[K Integer](k K) uint64 {
+	if K(0)-1 < 0 {
+		return uint64(int64(k)) ^ (1 << 63)
+	}
+	return uint64(k)
+}
+
+// RadixSortLSD sorts s by the integer keys returned by key, one byte at a
+// time starting from the least significant. It is stable and runs in O(n)
+// passes over the data, skipping bytes that are the same for every key.
+func RadixSortLSD[S ~[]T, T any, K Integer](s S, key func(T) K) {
+	r := newIntRadixSort(s, key)
+	for d := r.depth - 1; d >= 0; d-- {
+		r.pass(0, len(s), d)
+	}
+}
+
+// RadixSortMSD sorts s by the integer keys returned by key, bucketing on the
+// most significant byte first and recursing into each bucket. Small buckets
+// are finished with insertion sort. It is stable.
+func RadixSortMSD[S ~[]T, T any, K Integer](s S, key func(T) K) {
+	newIntRadixSort(s, key).msd(0, len(s), 0)
+}
+
+// StringRadixSortLSD sorts s by the byte string keys returned by key, one
+// position at a time starting from the last. Shorter keys sort before longer
+// keys they are a prefix of. It is stable and does one pass per byte of the
+// longest key, so it suits fixed-length keys such as codes or hashes.
+func StringRadixSortLSD[S ~[]T, T any, K ByteString](s S, key func(T) K) {
+	r := newStringRadixSort(s, key)
+	for d := r.depth - 1; d >= 0; d-- {
+		r.pass(0, len(s), d)
+	}
+}
+
+// StringRadixSortMSD sorts s by the byte string keys returned by key,
+// bucketing on the first byte and recursing into each bucket, so it only
+// looks at as many bytes as it needs to tell keys apart. It is stable.
+func StringRadixSortMSD[S ~[]T, T any, K ByteString](s S, key func(T) K) {
+	newStringRadixSort(s, key).msd(0, len(s), 0)
+}
+
+// radixSort sorts s by precomputed keys whose digits are read through
+// digit. Elements and keys are always moved together.
+type radixSort[S ~[]T, T any, K any] struct {
+	s, buf     S
+	keys, kbuf []K
+	// depth is the number of digit positions in the longest key.
+	depth int
+	// digit returns 0 if k has no digit at position d and the digit plus
+	// one otherwise.
+	digit func(k K, d int) int
+}
+
+func newIntRadixSort[S ~[]T, T any, K Integer](s S, key func(T) K) *radixSort[S, T, uint64] {
+	keys := make([]uint64, len(s))
+	for i, el := range s {
+		keys[i] = sortableBits(key(el))
+	}
+
+	return &radixSort[S, T, uint64]{
+		s:     s,
+		buf:   make(S, len(s)),
+		keys:  keys,
+		kbuf:  make([]uint64, len(s)),
+		depth: 8,
+		digit: func(k uint64, d int) int {
+			return int(k>>(56-8*d)&0xff) + 1
+		},
+	}
+}
+
+func newStringRadixSort[S ~[]T, T any, K ByteString](s S, key func(T) K) *radixSort[S, T, K] {
+	keys := make([]K, len(s))
+	depth := 0
+	for i, el := range s {
+		keys[i] = key(el)
+		depth = max(depth, len(keys[i]))
+	}
+
+	return &radixSort[S, T, K]{
+		s:     s,
+		buf:   make(S, len(s)),
+		keys:  keys,
+		kbuf:  make([]K, len(s)),
+		depth: depth,
+		digit: func(k K, d int) int {
+			if d < len(k) {
+				return int(k[d]) + 1
+			}
+			return 0
+		},
+	}
+}
+
+// pass stably distributes s[lo:hi] by the digit at position d and returns
+// the bucket offsets: bucket b ends up in [lo+off[b], lo+off[b+1]).
+func (r *radixSort[S, T, K]) pass(lo, hi, d int) [radixBuckets + 1]int {
+	var off [radixBuckets + 1]int
+	for _, k := range r.keys[lo:hi] {
+		off[r.digit(k, d)+1]++
+	}
+
+	skip := false
+	for b := 1; b <= radixBuckets; b++ {
+		skip = skip || off[b] == hi-lo
+		off[b] += off[b-1]
+	}
+	if skip {
+		return off
+	}
+
+	next := off
+	for i := lo; i < hi; i++ {
+		b := r.digit(r.keys[i], d)
+		r.buf[lo+next[b]] = r.s[i]
+		r.kbuf[lo+next[b]] = r.keys[i]
+		next[b]++
+	}
+	copy(r.s[lo:hi], r.buf[lo:hi])
+	copy(r.keys[lo:hi], r.kbuf[lo:hi])
+	return off
+}
+
+func (r *radixSort[S, T, K]) msd(lo, hi, d int) {
+	if d >= r.depth {
+		return
+	}
+	if hi-lo <= insertionThreshold {
+		r.insertionSort(lo, hi, d)
+		return
+	}
+
+	off := r.pass(lo, hi, d)
+
+	// Bucket 0 holds keys that ended before d, which are all equal.
+	for b := 1; b < radixBuckets; b++ {
+		if off[b+1]-off[b] > 1 {
+			r.msd(lo+off[b], lo+off[b+1], d+1)
+		}
+	}
+}
+
+// insertionSort sorts s[lo:hi] given that every key agrees on the digits
+// before position d.
+func (r *radixSort[S, T, K]) insertionSort(lo, hi, d int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && r.compare(r.keys[j-1], r.keys[j], d) > 0; j-- {
+			r.s[j-1], r.s[j] = r.s[j], r.s[j-1]
+			r.keys[j-1], r.keys[j] = r.keys[j], r.keys[j-1]
+		}
+	}
+}
+
+func (r *radixSort[S, T, K]) compare(a, b K, d int) int {
+	for ; d < r.depth; d++ {
+		da, db := r.digit(a, d), r.digit(b, d)
+		if da != db {
+			return da - db
+		}
+		if da == 0 {
+			return 0
+		}
+	}
+	return 0
+}
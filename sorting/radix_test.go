@@ -0,0 +1,195 @@
+package sorting
+
+import (
+	"cmp"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type keyed[K any] struct {
+	key K
+	seq int
+}
+
+func keyOf[K any](k keyed[K]) K {
+	return k.key
+}
+
+func toKeyed[K any](keys []K) []keyed[K] {
+	s := make([]keyed[K], len(keys))
+	for i, k := range keys {
+		s[i] = keyed[K]{key: k, seq: i}
+	}
+	return s
+}
+
+// checkSortedStable checks got against a stable comparison sort of input by
+// key, which also verifies that equal keys kept their order.
+func checkSortedStable[K any](t *testing.T, input, got []keyed[K], comp func(K, K) int) {
+	t.Helper()
+
+	want := slices.Clone(input)
+	slices.SortStableFunc(want, func(a, b keyed[K]) int {
+		return comp(a.key, b.key)
+	})
+
+	for i := range want {
+		if comp(got[i].key, want[i].key) != 0 || got[i].seq != want[i].seq {
+			t.Fatalf("at index %d got %+v; want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRadixSortInts(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	random := func(n int, f func() int64) []int64 {
+		s := make([]int64, n)
+		for i := range s {
+			s[i] = f()
+		}
+		return s
+	}
+
+	cases := []struct {
+		name string
+		keys []int64
+	}{
+		{name: "empty arr", keys: []int64{}},
+		{name: "single element arr", keys: []int64{-3}},
+		{name: "small range arr", keys: random(500, func() int64 { return rng.Int64N(10) - 5 })},
+		{name: "extremes arr", keys: []int64{math.MaxInt64, -1, 0, math.MinInt64, 1, math.MinInt64, math.MaxInt64}},
+		{name: "wide range arr", keys: random(3000, func() int64 { return rng.Int64() - math.MaxInt64/2 })},
+	}
+
+	sorts := map[string]func([]keyed[int64], func(keyed[int64]) int64){
+		"lsd":      RadixSortLSD[[]keyed[int64], keyed[int64], int64],
+		"msd":      RadixSortMSD[[]keyed[int64], keyed[int64], int64],
+		"counting": CountingSort[[]keyed[int64], keyed[int64], int64],
+	}
+
+	for name, sort := range sorts {
+		for _, tt := range cases {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				input := toKeyed(tt.keys)
+				got := slices.Clone(input)
+				sort(got, keyOf)
+				checkSortedStable(t, input, got, cmp.Compare[int64])
+			})
+		}
+	}
+}
+
+func TestRadixSortKeyTypes(t *testing.T) {
+	int8s := toKeyed([]int8{127, -128, 0, -1, 1, 5, -5, 127})
+	got8 := slices.Clone(int8s)
+	RadixSortMSD(got8, keyOf)
+	checkSortedStable(t, int8s, got8, cmp.Compare[int8])
+
+	uints := toKeyed([]uint64{math.MaxUint64, 0, 1 << 63, 1<<63 - 1, 42})
+	gotU := slices.Clone(uints)
+	RadixSortLSD(gotU, keyOf)
+	checkSortedStable(t, uints, gotU, cmp.Compare[uint64])
+
+	uint16s := toKeyed([]uint16{300, 2, 65535, 300, 0})
+	gotC := slices.Clone(uint16s)
+	CountingSort(gotC, keyOf)
+	checkSortedStable(t, uint16s, gotC, cmp.Compare[uint16])
+}
+
+func TestCountingSortWideKeys(t *testing.T) {
+	signed := toKeyed([]int64{math.MaxInt64, math.MinInt64, 0, math.MinInt64, -1, math.MaxInt64})
+	gotS := slices.Clone(signed)
+	CountingSort(gotS, keyOf)
+	checkSortedStable(t, signed, gotS, cmp.Compare[int64])
+
+	unsigned := toKeyed([]uint64{math.MaxUint64, math.MaxUint64 - 1, 0, math.MaxUint64, 1 << 30})
+	gotU := slices.Clone(unsigned)
+	CountingSort(gotU, keyOf)
+	checkSortedStable(t, unsigned, gotU, cmp.Compare[uint64])
+
+	nearMax := toKeyed([]uint64{math.MaxUint64, math.MaxUint64 - 2, math.MaxUint64, math.MaxUint64 - 1})
+	gotN := slices.Clone(nearMax)
+	CountingSort(gotN, keyOf)
+	checkSortedStable(t, nearMax, gotN, cmp.Compare[uint64])
+}
+
+func TestStringRadixSort(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	randomWords := make([]string, 2000)
+	for i := range randomWords {
+		b := make([]byte, rng.IntN(6))
+		for j := range b {
+			b[j] = "abc\x00\xff"[rng.IntN(5)]
+		}
+		randomWords[i] = string(b)
+	}
+
+	cases := []struct {
+		name string
+		keys []string
+	}{
+		{name: "empty arr", keys: []string{}},
+		{name: "prefixes arr", keys: []string{"abc", "ab", "", "a", "abcd", "ab", "b"}},
+		{name: "words arr", keys: strings.Fields("the quick brown fox jumps over the lazy dog and the gopher")},
+		{name: "random bytes arr", keys: randomWords},
+	}
+
+	sorts := map[string]func([]keyed[string], func(keyed[string]) string){
+		"lsd": StringRadixSortLSD[[]keyed[string], keyed[string], string],
+		"msd": StringRadixSortMSD[[]keyed[string], keyed[string], string],
+	}
+
+	for name, sort := range sorts {
+		for _, tt := range cases {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				input := toKeyed(tt.keys)
+				got := slices.Clone(input)
+				sort(got, keyOf)
+				checkSortedStable(t, input, got, strings.Compare)
+			})
+		}
+	}
+}
+
+func TestStringRadixSortBytes(t *testing.T) {
+	keys := [][]byte{[]byte("zz"), nil, []byte("a"), []byte("za"), {}}
+	StringRadixSortMSD(keys, func(b []byte) []byte { return b })
+
+	want := []string{"", "", "a", "za", "zz"}
+	for i, k := range keys {
+		if string(k) != want[i] {
+			t.Fatalf("at index %d got %q; want %q", i, k, want[i])
+		}
+	}
+}
+
+func BenchmarkIntegerSorts(b *testing.B) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	input := make([]uint32, 100_000)
+	for i := range input {
+		input[i] = rng.Uint32()
+	}
+	identity := func(k uint32) uint32 { return k }
+
+	sorts := []struct {
+		name string
+		sort func([]uint32)
+	}{
+		{name: "lsd", sort: func(s []uint32) { RadixSortLSD(s, identity) }},
+		{name: "msd", sort: func(s []uint32) { RadixSortMSD(s, identity) }},
+		{name: "pdq", sort: func(s []uint32) { PdqSort(s, cmp.Compare[uint32]) }},
+	}
+
+	for _, alg := range sorts {
+		b.Run(alg.name, func(b *testing.B) {
+			arr := make([]uint32, len(input))
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				alg.sort(arr)
+			}
+		})
+	}
+}
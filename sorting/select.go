@@ -0,0 +1,130 @@
+package sorting
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+// NthElement rearranges s so that s[n] is the element that would be there if
+// s were sorted, every element before it is not greater and every element
+// after it is not less. It uses quickselect with the same pivot and
+// partitioning as QuickSort and switches to median-of-medians pivots if the
+// range stops shrinking fast enough, so it runs in O(n) on average and in
+// the worst case. It panics if n is not a valid index into s.
+func NthElement[S ~[]T, T any](s S, n int, comp func(T, T) int) {
+	checkSelectIndex(n, len(s))
+	nthElement(s, 0, len(s), n, 2*bits.Len(uint(len(s))), comp)
+}
+
+// NthElementLinear is NthElement with median-of-medians pivots from the
+// start. It always runs in linear time, but with a larger constant factor
+// than NthElement on typical input.
+func NthElementLinear[S ~[]T, T any](s S, n int, comp func(T, T) int) {
+	checkSelectIndex(n, len(s))
+	nthElement(s, 0, len(s), n, 0, comp)
+}
+
+func checkSelectIndex(n, length int) {
+	if n < 0 || n >= length {
+		panic(fmt.Sprintf("sorting: index %d out of range [0:%d]", n, length))
+	}
+}
+
+// nthElement selects n within s[lo:hi]. Once budget runs out every pivot is
+// a median of medians, which discards at least 3/10 of the range per step.
+func nthElement[S ~[]T, T any](s S, lo, hi, n, budget int, comp func(T, T) int) {
+	for hi-lo > insertionThreshold {
+		var pivot int
+		if budget > 0 {
+			budget--
+			pivot = medianOfThree(s, lo, lo+(hi-lo)/2, hi-1, comp)
+		} else {
+			pivot = medianOfMedians(s, lo, hi, comp)
+		}
+
+		lt, gt := partition3(s, lo, hi, pivot, comp)
+		switch {
+		case n < lt:
+			hi = lt
+		case n >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+	insertionSort(s, lo, hi, comp)
+}
+
+// medianOfMedians moves the median of every group of five in s[lo:hi] to the
+// front of the range, selects the median of those and returns its index.
+func medianOfMedians[S ~[]T, T any](s S, lo, hi int, comp func(T, T) int) int {
+	groups := 0
+	for g := lo; g < hi; g += 5 {
+		end := min(g+5, hi)
+		insertionSort(s, g, end, comp)
+		mid := g + (end-g)/2
+		s[lo+groups], s[mid] = s[mid], s[lo+groups]
+		groups++
+	}
+
+	mid := lo + groups/2
+	nthElement(s, lo, lo+groups, mid, 0, comp)
+	return mid
+}
+
+// PartialSort rearranges s so that s[:k] holds its k smallest elements in
+// sorted order. The order of the rest is unspecified. It runs in
+// O(n + k log k) and panics if k is negative or greater than len(s).
+func PartialSort[S ~[]T, T any](s S, k int, comp func(T, T) int) {
+	if k < 0 || k > len(s) {
+		panic(fmt.Sprintf("sorting: partial sort length %d out of range [0:%d]", k, len(s)))
+	}
+
+	if k < len(s) {
+		nthElement(s, 0, len(s), k, 2*bits.Len(uint(len(s))), comp)
+	}
+	introSort(s, 0, k, 2*bits.Len(uint(k)), comp)
+}
+
+// TopK returns the k smallest elements of s in sorted order without
+// modifying s. Pass a reversed comparator to get the k largest. It keeps a
+// bounded max-heap of the best k elements seen so far, so it runs in
+// O(n log k) with O(k) extra memory. If k is greater than len(s) all of s is
+// returned, and it panics if k is negative.
+func TopK[S ~[]T, T any](s S, k int, comp func(T, T) int) S {
+	if k < 0 {
+		panic(fmt.Sprintf("sorting: negative top-k length %d", k))
+	}
+
+	k = min(k, len(s))
+	h := slices.Clone(s[:k])
+	for i := k/2 - 1; i >= 0; i-- {
+		siftDown(h, i, k, comp)
+	}
+	for _, el := range s[k:] {
+		if k > 0 && comp(el, h[0]) < 0 {
+			h[0] = el
+			siftDown(h, 0, k, comp)
+		}
+	}
+	heapSort(h, 0, k, comp)
+	return h
+}
+
+// IsSorted reports whether s is sorted according to comp.
+func IsSorted[S ~[]T, T any](s S, comp func(T, T) int) bool {
+	return IsSortedUntil(s, comp) == len(s)
+}
+
+// IsSortedUntil returns the length of the longest sorted prefix of s, which
+// is the index of the first element that is less than its predecessor, or
+// len(s) if there is none.
+func IsSortedUntil[S ~[]T, T any](s S, comp func(T, T) int) int {
+	for i := 1; i < len(s); i++ {
+		if comp(s[i], s[i-1]) < 0 {
+			return i
+		}
+	}
+	return len(s)
+}
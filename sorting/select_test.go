@@ -0,0 +1,209 @@
+package sorting
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// checkNth reports whether s is partitioned around s[n] and s[n] is want.
+func checkNth(t *testing.T, s []int, n, want int) {
+	t.Helper()
+
+	if s[n] != want {
+		t.Errorf("got s[%d] = %d; want %d", n, s[n], want)
+	}
+	for i, v := range s {
+		if (i < n && v > s[n]) || (i > n && v < s[n]) {
+			t.Fatalf("s[%d] = %d is on the wrong side of s[%d] = %d", i, v, n, s[n])
+		}
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	selects := []struct {
+		name string
+		fn   func([]int, int, func(int, int) int)
+	}{
+		{name: "quickselect", fn: NthElement[[]int, int]},
+		{name: "median of medians", fn: NthElementLinear[[]int, int]},
+	}
+
+	for _, sel := range selects {
+		for _, tt := range testInputs(500) {
+			if len(tt.arr) == 0 {
+				continue
+			}
+
+			sorted := slices.Clone(tt.arr)
+			slices.Sort(sorted)
+			for _, n := range []int{0, len(tt.arr) / 3, len(tt.arr) / 2, len(tt.arr) - 1} {
+				t.Run(fmt.Sprintf("%s/%s/%d", sel.name, tt.name, n), func(t *testing.T) {
+					s := slices.Clone(tt.arr)
+					sel.fn(s, n, cmp.Compare[int])
+					checkNth(t, s, n, sorted[n])
+				})
+			}
+		}
+	}
+}
+
+func TestNthElementOutOfRange(t *testing.T) {
+	for _, n := range []int{-1, 3} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			defer func() {
+				if err := recover(); err == nil {
+					t.Error("expected to panic but didn't")
+				}
+			}()
+
+			NthElement([]int{3, 1, 2}, n, cmp.Compare[int])
+		})
+	}
+}
+
+func TestNthElementLinearOnAdversarial(t *testing.T) {
+	const n = 1 << 14
+
+	selects := map[string]func([]int, int, func(int, int) int){
+		"quickselect":       NthElement[[]int, int],
+		"median of medians": NthElementLinear[[]int, int],
+	}
+
+	for name, sel := range selects {
+		for _, tt := range testInputs(n, adversarialShapes...) {
+			if len(tt.arr) == 0 {
+				continue
+			}
+
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				comparisons := 0
+				sel(tt.arr, len(tt.arr)/2, func(a, b int) int {
+					comparisons++
+					return cmp.Compare(a, b)
+				})
+
+				if limit := 40 * n; comparisons > limit {
+					t.Errorf("got %d comparisons; want at most %d", comparisons, limit)
+				}
+			})
+		}
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	for _, tt := range testInputs(500) {
+		sorted := slices.Clone(tt.arr)
+		slices.Sort(sorted)
+		for _, k := range []int{0, 1, len(tt.arr) / 2, len(tt.arr)} {
+			if k > len(tt.arr) {
+				continue
+			}
+
+			t.Run(fmt.Sprintf("%s/%d", tt.name, k), func(t *testing.T) {
+				s := slices.Clone(tt.arr)
+				PartialSort(s, k, cmp.Compare[int])
+
+				if !slices.Equal(s[:k], sorted[:k]) {
+					t.Errorf("got prefix %v; want %v", s[:k], sorted[:k])
+				}
+				rest := slices.Sorted(slices.Values(s[k:]))
+				if !slices.Equal(rest, sorted[k:]) {
+					t.Errorf("got rest %v; want a permutation of %v", s[k:], sorted[k:])
+				}
+			})
+		}
+	}
+}
+
+func TestPartialSortOutOfRange(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Error("expected to panic but didn't")
+		}
+	}()
+
+	PartialSort([]int{3, 1, 2}, 4, cmp.Compare[int])
+}
+
+func TestTopK(t *testing.T) {
+	for _, tt := range testInputs(500) {
+		sorted := slices.Clone(tt.arr)
+		slices.Sort(sorted)
+		for _, k := range []int{0, 1, 5, len(tt.arr) + 1} {
+			t.Run(fmt.Sprintf("%s/%d", tt.name, k), func(t *testing.T) {
+				input := slices.Clone(tt.arr)
+				got := TopK(input, k, cmp.Compare[int])
+
+				want := sorted[:min(k, len(sorted))]
+				if !slices.Equal(got, want) {
+					t.Errorf("got %v; want %v", got, want)
+				}
+				if !slices.Equal(input, tt.arr) {
+					t.Error("input was modified")
+				}
+			})
+		}
+	}
+}
+
+func TestTopKLargest(t *testing.T) {
+	got := TopK([]int{5, 9, 1, 7, 3, 8}, 3, func(a, b int) int {
+		return cmp.Compare(b, a)
+	})
+
+	if want := []int{9, 8, 7}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	cases := []struct {
+		name  string
+		arr   []int
+		until int
+	}{
+		{name: "empty arr", arr: []int{}, until: 0},
+		{name: "single element arr", arr: []int{1}, until: 1},
+		{name: "sorted arr", arr: []int{1, 2, 2, 3}, until: 4},
+		{name: "unsorted at start", arr: []int{2, 1, 3}, until: 1},
+		{name: "unsorted at end", arr: []int{1, 2, 3, 0}, until: 3},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSortedUntil(tt.arr, cmp.Compare[int]); got != tt.until {
+				t.Errorf("IsSortedUntil: got %d; want %d", got, tt.until)
+			}
+			if got, want := IsSorted(tt.arr, cmp.Compare[int]), tt.until == len(tt.arr); got != want {
+				t.Errorf("IsSorted: got %t; want %t", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkSelect(b *testing.B) {
+	inputs := testInputs(2000)
+	input := inputs[len(inputs)-1].arr
+
+	selects := []struct {
+		name string
+		fn   func([]int)
+	}{
+		{name: "NthElement", fn: func(s []int) { NthElement(s, len(s)/2, cmp.Compare[int]) }},
+		{name: "NthElementLinear", fn: func(s []int) { NthElementLinear(s, len(s)/2, cmp.Compare[int]) }},
+		{name: "PartialSort", fn: func(s []int) { PartialSort(s, 100, cmp.Compare[int]) }},
+		{name: "TopK", fn: func(s []int) { TopK(s, 100, cmp.Compare[int]) }},
+	}
+
+	for _, sel := range selects {
+		b.Run(sel.name, func(b *testing.B) {
+			arr := make([]int, len(input))
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				sel.fn(arr)
+			}
+		})
+	}
+}
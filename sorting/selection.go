@@ -0,0 +1,18 @@
+package sorting
+
+// SelectionSort sorts s in place by repeatedly moving the smallest remaining
+// element to the front. It always does O(n²) comparisons but at most n-1
+// swaps. It is not stable.
+func SelectionSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	for i := range s {
+		least := i
+		for j := i + 1; j < len(s); j++ {
+			if comp(s[j], s[least]) < 0 {
+				least = j
+			}
+		}
+		if least != i {
+			s[i], s[least] = s[least], s[i]
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package sorting
+
+// ShellSort sorts s in place with insertion sorts over shrinking gaps from
+// Knuth's 1, 4, 13, 40, ... sequence. It is not stable.
+func ShellSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	gap := 1
+	for gap < len(s)/3 {
+		gap = 3*gap + 1
+	}
+
+	for ; gap > 0; gap /= 3 {
+		for i := gap; i < len(s); i++ {
+			for j := i; j >= gap && comp(s[j-gap], s[j]) > 0; j -= gap {
+				s[j-gap], s[j] = s[j], s[j-gap]
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package sorting
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// shape generates an input of n elements with a structure worth sorting.
+type shape struct {
+	name string
+	gen  func(n int) []int
+}
+
+// sequence returns the n values f(0), ..., f(n-1).
+func sequence(n int, f func(int) int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = f(i)
+	}
+	return s
+}
+
+// testInputs returns a fresh set of inputs covering the shapes that tend to
+// break sorts: empty, tiny, presorted, reversed, duplicates and random data.
+// The generated shapes have n elements and extra shapes are added after them.
+func testInputs(n int, extra ...shape) []struct {
+	name string
+	arr  []int
+} {
+	rng := rand.New(rand.NewPCG(1, 2))
+	random := func(n, limit int) []int {
+		return sequence(n, func(int) int { return rng.IntN(limit) })
+	}
+
+	inputs := []struct {
+		name string
+		arr  []int
+	}{
+		{name: "empty arr", arr: []int{}},
+		{name: "single element arr", arr: []int{1}},
+		{name: "two elements arr", arr: []int{2, 1}},
+		{name: "standard arr", arr: []int{1, 3, 7, 4, 2}},
+		{name: "only 1 element to sort arr", arr: []int{0, 0, 0, 1, 0}},
+		{name: "random small arr", arr: random(33, 1000)},
+		{name: "sorted arr", arr: sequence(n, func(i int) int { return i })},
+		{name: "reversed arr", arr: sequence(n, func(i int) int { return n - i })},
+		{name: "all equal arr", arr: sequence(n, func(int) int { return 7 })},
+		{name: "organ pipe arr", arr: sequence(n, func(i int) int { return min(i, n-1-i) })},
+		{name: "sawtooth arr", arr: sequence(n, func(i int) int { return i % 17 })},
+		{name: "few distinct arr", arr: random(n, 4)},
+		{name: "random large arr", arr: random(n, 1_000_000)},
+	}
+	for _, sh := range extra {
+		inputs = append(inputs, struct {
+			name string
+			arr  []int
+		}{name: sh.name + " arr", arr: sh.gen(n)})
+	}
+	return inputs
+}
+
+func TestSortUnknownAlgorithm(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Error("expected to panic but didn't")
+		}
+	}()
+
+	Sort(Algorithm(-1), []int{2, 1}, cmp.Compare[int])
+}
+
+func TestSortsInts(t *testing.T) {
+	for _, alg := range Algorithms {
+		for _, tt := range testInputs(500) {
+			t.Run(alg.String()+"/"+tt.name, func(t *testing.T) {
+				want := slices.Clone(tt.arr)
+				slices.SortFunc(want, cmp.Compare[int])
+
+				Sort(alg, tt.arr, cmp.Compare[int])
+				if !slices.Equal(tt.arr, want) {
+					t.Errorf("got %v; want %v", tt.arr, want)
+				}
+			})
+		}
+	}
+}
+
+func TestSortsReversedComparator(t *testing.T) {
+	desc := func(a, b int) int { return cmp.Compare(b, a) }
+
+	for _, alg := range Algorithms {
+		for _, tt := range testInputs(500) {
+			t.Run(alg.String()+"/"+tt.name, func(t *testing.T) {
+				want := slices.Clone(tt.arr)
+				slices.SortFunc(want, desc)
+
+				Sort(alg, tt.arr, desc)
+				if !slices.Equal(tt.arr, want) {
+					t.Errorf("got %v; want %v", tt.arr, want)
+				}
+			})
+		}
+	}
+}
+
+func TestSortsStrings(t *testing.T) {
+	words := strings.Fields("the quick brown fox jumps over the lazy dog while the gopher sorts every word it can find")
+
+	for _, alg := range Algorithms {
+		t.Run(alg.String(), func(t *testing.T) {
+			arr := slices.Clone(words)
+			want := slices.Clone(words)
+			slices.SortFunc(want, strings.Compare)
+
+			Sort(alg, arr, strings.Compare)
+			if !slices.Equal(arr, want) {
+				t.Errorf("got %v; want %v", arr, want)
+			}
+		})
+	}
+}
+
+func BenchmarkSorts(b *testing.B) {
+	for _, size := range []int{100, 10_000} {
+		rng := rand.New(rand.NewPCG(1, 2))
+		input := make([]int, size)
+		for i := range input {
+			input[i] = rng.Int()
+		}
+
+		for _, alg := range Algorithms {
+			if size > 1000 && (alg == Insertion || alg == Selection) {
+				continue
+			}
+
+			b.Run(fmt.Sprintf("%s/%d", alg, size), func(b *testing.B) {
+				arr := make([]int, size)
+				for i := 0; i < b.N; i++ {
+					copy(arr, input)
+					Sort(alg, arr, cmp.Compare[int])
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package sorting
+
+// StableSort sorts s in place keeping equal elements in their original
+// order. It uses TimSort.
+func StableSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	TimSort(s, comp)
+}
+
+// minMerge is the size below which TimSort skips run detection and just
+// insertion sorts the whole slice.
+const minMerge = 64
+
+type run struct {
+	lo, n int
+}
+
+// TimSort sorts s in place by splitting it into naturally ordered runs,
+// extending short runs with binary insertion sort and merging them so that
+// run lengths stay balanced. It is stable, O(n log n) in the worst case and
+// O(n) on input that is already sorted or reversed.
+func TimSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	if len(s) < 2 {
+		return
+	}
+	if len(s) < minMerge {
+		binaryInsertionSort(s, 0, len(s), countRun(s, 0, len(s), comp), comp)
+		return
+	}
+
+	t := timSort[S, T]{s: s, comp: comp}
+	minRun := minRunLength(len(s))
+
+	for lo := 0; lo < len(s); {
+		n := countRun(s, lo, len(s), comp)
+		if n < minRun {
+			force := min(minRun, len(s)-lo)
+			binaryInsertionSort(s, lo, lo+force, lo+n, comp)
+			n = force
+		}
+
+		t.runs = append(t.runs, run{lo: lo, n: n})
+		t.mergeCollapse()
+		lo += n
+	}
+	t.mergeForceCollapse()
+}
+
+type timSort[S ~[]T, T any] struct {
+	s    S
+	comp func(T, T) int
+	runs []run
+	buf  S
+}
+
+// mergeCollapse merges runs until every three consecutive runs on the stack
+// satisfy A > B + C and B > C, with the fix from "OpenJDK's
+// java.utils.Collection.sort() is broken" applied to the invariant check.
+func (t *timSort[S, T]) mergeCollapse() {
+	for len(t.runs) > 1 {
+		n := len(t.runs) - 2
+		if (n > 0 && t.runs[n-1].n <= t.runs[n].n+t.runs[n+1].n) ||
+			(n > 1 && t.runs[n-2].n <= t.runs[n-1].n+t.runs[n].n) {
+			if t.runs[n-1].n < t.runs[n+1].n {
+				n--
+			}
+		} else if t.runs[n].n > t.runs[n+1].n {
+			return
+		}
+		t.mergeAt(n)
+	}
+}
+
+func (t *timSort[S, T]) mergeForceCollapse() {
+	for len(t.runs) > 1 {
+		n := len(t.runs) - 2
+		if n > 0 && t.runs[n-1].n < t.runs[n+1].n {
+			n--
+		}
+		t.mergeAt(n)
+	}
+}
+
+// mergeAt merges the runs at i and i+1 on the stack.
+func (t *timSort[S, T]) mergeAt(i int) {
+	a, b := t.runs[i], t.runs[i+1]
+	lo, mid, hi := a.lo, a.lo+a.n, b.lo+b.n
+
+	t.runs[i].n += b.n
+	t.runs = append(t.runs[:i+1], t.runs[i+2:]...)
+
+	if t.comp(t.s[mid-1], t.s[mid]) <= 0 {
+		return
+	}
+
+	if cap(t.buf) < a.n {
+		t.buf = make(S, a.n, max(a.n, len(t.s)/2))
+	}
+	left := t.buf[:a.n]
+	copy(left, t.s[lo:mid])
+	merge(t.s[lo:hi], left, t.s[mid:hi], t.comp)
+}
+
+// minRunLength returns the minimum run length for a slice of length n, a
+// value in [minMerge/2, minMerge] chosen so that n/minRun is a power of two
+// or just below one.
+func minRunLength(n int) int {
+	r := 0
+	for n >= minMerge {
+		r |= n & 1
+		n >>= 1
+	}
+	return n + r
+}
+
+// countRun returns the length of the run starting at lo, reversing it first
+// if it is strictly descending. Descending runs must be strict so that
+// reversing them cannot reorder equal elements.
+func countRun[S ~[]T, T any](s S, lo, hi int, comp func(T, T) int) int {
+	i := lo + 1
+	if i == hi {
+		return 1
+	}
+
+	if comp(s[i], s[lo]) < 0 {
+		for i++; i < hi && comp(s[i], s[i-1]) < 0; i++ {
+		}
+		reverse(s[lo:i])
+	} else {
+		for i++; i < hi && comp(s[i], s[i-1]) >= 0; i++ {
+		}
+	}
+	return i - lo
+}
+
+func reverse[S ~[]T, T any](s S) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// binaryInsertionSort sorts s[lo:hi] given that s[lo:start] is already
+// sorted. Each element is placed after any equal elements before it.
+func binaryInsertionSort[S ~[]T, T any](s S, lo, hi, start int, comp func(T, T) int) {
+	for i := max(start, lo+1); i < hi; i++ {
+		pivot := s[i]
+
+		left, right := lo, i
+		for left < right {
+			mid := int(uint(left+right) >> 1)
+			if comp(pivot, s[mid]) < 0 {
+				right = mid
+			} else {
+				left = mid + 1
+			}
+		}
+
+		copy(s[left+1:i+1], s[left:i])
+		s[left] = pivot
+	}
+}
+
+// blockSize is the size of the blocks InPlaceMergeSort insertion sorts before
+// it starts merging.
+const blockSize = 20
+
+// InPlaceMergeSort is a stable merge sort that needs no extra memory. Blocks
+// are insertion sorted and then merged with the SymMerge algorithm by Kim and
+// Kutzner, which merges by rotating ranges instead of copying to a buffer.
+// It makes O(n log n) comparisons and O(n log² n) swaps.
+func InPlaceMergeSort[S ~[]T, T any](s S, comp func(T, T) int) {
+	n := len(s)
+
+	a, b := 0, blockSize
+	for b <= n {
+		insertionSort(s, a, b, comp)
+		a = b
+		b += blockSize
+	}
+	insertionSort(s, a, n, comp)
+
+	for size := blockSize; size < n; size *= 2 {
+		a, b = 0, 2*size
+		for b <= n {
+			symMerge(s, a, a+size, b, comp)
+			a = b
+			b += 2 * size
+		}
+		if m := a + size; m < n {
+			symMerge(s, a, m, n, comp)
+		}
+	}
+}
+
+// symMerge merges the sorted ranges s[a:m] and s[m:b] in place.
+func symMerge[S ~[]T, T any](s S, a, m, b int, comp func(T, T) int) {
+	// A single element on either side is inserted with a binary search and
+	// a shift, which is cheaper than the general case.
+	if m-a == 1 {
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if comp(s[h], s[a]) < 0 {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			s[k], s[k+1] = s[k+1], s[k]
+		}
+		return
+	}
+
+	if b-m == 1 {
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if comp(s[m], s[h]) >= 0 {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			s[k], s[k-1] = s[k-1], s[k]
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if comp(s[p-c], s[c]) >= 0 {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotate(s, start, m, end)
+	}
+	if a < start && start < mid {
+		symMerge(s, a, start, mid, comp)
+	}
+	if mid < end && end < b {
+		symMerge(s, mid, end, b, comp)
+	}
+}
+
+// rotate swaps the ranges s[a:m] and s[m:b] using block swaps.
+func rotate[S ~[]T, T any](s S, a, m, b int) {
+	i := m - a
+	j := b - m
+
+	for i != j {
+		if i > j {
+			swapRange(s, m-i, m, j)
+			i -= j
+		} else {
+			swapRange(s, m-i, m+j-i, i)
+			j -= i
+		}
+	}
+	swapRange(s, m-i, m, i)
+}
+
+func swapRange[S ~[]T, T any](s S, a, b, n int) {
+	for i := range n {
+		s[a+i], s[b+i] = s[b+i], s[a+i]
+	}
+}
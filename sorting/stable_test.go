@@ -0,0 +1,148 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	bubblesort "github.com/zukofett/go_algo/bubble_sort"
+)
+
+type record struct {
+	key int
+	seq int
+}
+
+func compRecords(a, b record) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// descendingRuns is a few long runs of equal keys in descending order.
+func descendingRuns(n int) []int {
+	return sequence(n, func(i int) int { return 10 - i/50 })
+}
+
+// testRecords is testInputs with each value used as the key of a record whose
+// seq field is its original position, so reordered equal keys show up.
+func testRecords(n int, extra ...shape) []struct {
+	name string
+	arr  []record
+} {
+	inputs := testInputs(n, extra...)
+	records := make([]struct {
+		name string
+		arr  []record
+	}, len(inputs))
+	for i, in := range inputs {
+		records[i].name = in.name
+		records[i].arr = make([]record, len(in.arr))
+		for j, key := range in.arr {
+			records[i].arr[j] = record{key: key, seq: j}
+		}
+	}
+	return records
+}
+
+// fewDistinctRecords returns a large input with long runs of equal keys.
+func fewDistinctRecords() []record {
+	for _, tt := range testRecords(5000) {
+		if tt.name == "few distinct arr" {
+			return tt.arr
+		}
+	}
+	panic("no few distinct input")
+}
+
+func TestStableSorts(t *testing.T) {
+	stableSorts := map[string]func([]record, func(record, record) int){
+		"StableSort": StableSort[[]record],
+		"BubbleSort": bubblesort.BubbleSort[[]record],
+	}
+	for _, alg := range Algorithms {
+		if alg.Stable() {
+			stableSorts[alg.String()] = func(s []record, comp func(record, record) int) {
+				Sort(alg, s, comp)
+			}
+		}
+	}
+
+	for name, sort := range stableSorts {
+		for _, tt := range testRecords(1000, shape{name: "descending runs", gen: descendingRuns}) {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				want := slices.Clone(tt.arr)
+				slices.SortStableFunc(want, compRecords)
+
+				sort(tt.arr, compRecords)
+				if !slices.Equal(tt.arr, want) {
+					t.Errorf("equal keys were reordered: got %v", tt.arr)
+				}
+			})
+		}
+	}
+}
+
+func TestAlgorithmStable(t *testing.T) {
+	want := map[Algorithm]bool{
+		Insertion:     true,
+		Selection:     false,
+		Shell:         false,
+		Merge:         true,
+		MergeBottomUp: true,
+		Quick:         false,
+		Heap:          false,
+		Tim:           true,
+		InPlaceMerge:  true,
+		Intro:         false,
+		Pdq:           false,
+	}
+
+	for _, alg := range Algorithms {
+		if alg.Stable() != want[alg] {
+			t.Errorf("%s: got stable %t; want %t", alg, alg.Stable(), want[alg])
+		}
+	}
+}
+
+func TestUnstableAlgorithmsReorder(t *testing.T) {
+	// Not a guarantee, but on this input every algorithm reported as
+	// unstable does reorder equal keys, which keeps Stable honest.
+	for _, alg := range Algorithms {
+		if alg.Stable() {
+			continue
+		}
+
+		arr := fewDistinctRecords()
+		Sort(alg, arr, compRecords)
+		if slices.IsSortedFunc(arr, func(a, b record) int {
+			return cmp.Or(compRecords(a, b), cmp.Compare(a.seq, b.seq))
+		}) {
+			t.Errorf("%s reported unstable but kept equal keys in order", alg)
+		}
+	}
+}
+
+func TestMinRunLength(t *testing.T) {
+	for n := range 5000 {
+		got := minRunLength(n)
+		if n < minMerge && got != n {
+			t.Fatalf("n %d: got min run %d; want %d", n, got, n)
+		}
+		if n >= minMerge && (got < minMerge/2 || got > minMerge) {
+			t.Fatalf("n %d: got min run %d; want value in [%d, %d]", n, got, minMerge/2, minMerge)
+		}
+	}
+}
+
+func BenchmarkStableSorts(b *testing.B) {
+	input := fewDistinctRecords()
+
+	for _, alg := range []Algorithm{Merge, MergeBottomUp, Tim, InPlaceMerge} {
+		b.Run(alg.String(), func(b *testing.B) {
+			arr := make([]record, len(input))
+			for i := 0; i < b.N; i++ {
+				copy(arr, input)
+				Sort(alg, arr, compRecords)
+			}
+		})
+	}
+}
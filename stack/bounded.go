@@ -0,0 +1,190 @@
+package stack
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrFullStack = errors.New("stack: stack is full")
+
+// OverflowPolicy decides what a BoundedStack does with a push once it holds
+// as many elements as its limit.
+type OverflowPolicy int
+
+const (
+	// Reject refuses the push and returns ErrFullStack.
+	Reject OverflowPolicy = iota
+	// DropOldest discards the bottom element to make room for the new one.
+	DropOldest
+	// Block waits until another goroutine pops an element.
+	Block
+)
+
+// BoundedStack is a stack that never holds more than a fixed number of
+// elements. Elements are kept in a ring buffer that grows on demand up to the
+// limit, so dropping the oldest element does not shift the rest.
+type BoundedStack[T any] struct {
+	mu      sync.Mutex
+	notFull sync.Cond
+	buf     []T
+	bottom  int
+	length  int
+	limit   int
+	policy  OverflowPolicy
+}
+
+func NewBoundedStack[T any](limit int, policy OverflowPolicy) *BoundedStack[T] {
+	if limit <= 0 {
+		panic("stack: bounded stack limit must be positive")
+	}
+
+	s := &BoundedStack[T]{
+		limit:  limit,
+		policy: policy,
+	}
+	s.notFull.L = &s.mu
+	return s
+}
+
+func (s *BoundedStack[T]) Push(val T) error {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for s.length == s.limit {
+		switch s.policy {
+		case DropOldest:
+			s.buf[s.bottom] = val
+			s.bottom = s.index(1)
+			return nil
+		case Block:
+			s.notFull.Wait()
+		default:
+			return ErrFullStack
+		}
+	}
+
+	if s.length == len(s.buf) {
+		s.resize(min(s.limit, max(2*len(s.buf), 1)))
+	}
+	s.buf[s.index(s.length)] = val
+	s.length++
+	return nil
+}
+
+func (s *BoundedStack[T]) Pop() T {
+	val, ok := s.TryPop()
+	if !ok {
+		panic(ErrEmptyStack)
+	}
+	return val
+}
+
+func (s *BoundedStack[T]) TryPop() (T, bool) {
+	var noop T
+	if s == nil {
+		return noop, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.length == 0 {
+		return noop, false
+	}
+
+	top := s.index(s.length - 1)
+	val := s.buf[top]
+	s.buf[top] = noop
+	s.length--
+	s.notFull.Signal()
+	return val, true
+}
+
+func (s *BoundedStack[T]) Peek() T {
+	val, ok := s.TryPeek()
+	if !ok {
+		panic(ErrEmptyStack)
+	}
+	return val
+}
+
+func (s *BoundedStack[T]) TryPeek() (T, bool) {
+	var noop T
+	if s == nil {
+		return noop, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.length == 0 {
+		return noop, false
+	}
+	return s.buf[s.index(s.length-1)], true
+}
+
+func (s *BoundedStack[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *BoundedStack[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.length
+}
+
+// Cap returns the hard limit of the stack, not the size of its buffer.
+func (s *BoundedStack[T]) Cap() int {
+	if s == nil {
+		return 0
+	}
+	return s.limit
+}
+
+// Shrink releases buffer space not used by the current elements.
+func (s *BoundedStack[T]) Shrink() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resize(s.length)
+}
+
+func (s *BoundedStack[T]) ToSlice() []T {
+	if s == nil {
+		return []T{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]T, 0, s.length)
+	for i := range s.length {
+		ret = append(ret, s.buf[s.index(i)])
+	}
+	return ret
+}
+
+// index maps a distance from the bottom of the stack to a buffer position.
+func (s *BoundedStack[T]) index(i int) int {
+	return (s.bottom + i) % len(s.buf)
+}
+
+func (s *BoundedStack[T]) resize(size int) {
+	buf := make([]T, size)
+	for i := range s.length {
+		buf[i] = s.buf[s.index(i)]
+	}
+	s.buf = buf
+	s.bottom = 0
+}
@@ -0,0 +1,211 @@
+package stack
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewBoundedStack(t *testing.T) {
+	stk := NewBoundedStack[int](5, Reject)
+
+	if stk == nil {
+		t.Fatal("failed to initialize stack")
+	}
+
+	if stk.Len() != 0 {
+		t.Fatalf("got len %d; want 0", stk.Len())
+	}
+
+	if stk.Cap() != 5 {
+		t.Fatalf("got cap %d; want 5", stk.Cap())
+	}
+}
+
+func TestNewBoundedStackEdge(t *testing.T) {
+	defer func() {
+		if err := recover(); err == nil {
+			t.Error("expected to panic but didn't")
+		}
+	}()
+
+	NewBoundedStack[int](0, Reject)
+}
+
+func TestBoundedStackPush(t *testing.T) {
+	cases := []struct {
+		name     string
+		limit    int
+		policy   OverflowPolicy
+		insert   []int
+		wantVals []int
+		wantErr  error
+	}{
+		{
+			name:     "reject below limit",
+			limit:    3,
+			policy:   Reject,
+			insert:   []int{1, 2},
+			wantVals: []int{1, 2},
+		}, {
+			name:     "reject at limit",
+			limit:    3,
+			policy:   Reject,
+			insert:   []int{1, 2, 3, 4},
+			wantVals: []int{1, 2, 3},
+			wantErr:  ErrFullStack,
+		}, {
+			name:     "drop oldest at limit",
+			limit:    3,
+			policy:   DropOldest,
+			insert:   []int{1, 2, 3, 4, 5},
+			wantVals: []int{3, 4, 5},
+		}, {
+			name:     "drop oldest wraps many times",
+			limit:    2,
+			policy:   DropOldest,
+			insert:   []int{1, 2, 3, 4, 5, 6, 7},
+			wantVals: []int{6, 7},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			stk := NewBoundedStack[int](tt.limit, tt.policy)
+
+			var err error
+			for _, val := range tt.insert {
+				err = stk.Push(val)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v; want %v", err, tt.wantErr)
+			}
+
+			if got := stk.ToSlice(); !slices.Equal(got, tt.wantVals) {
+				t.Fatalf("got %v; want %v", got, tt.wantVals)
+			}
+
+			if stk.Len() > stk.Cap() {
+				t.Fatalf("len %d exceeds cap %d", stk.Len(), stk.Cap())
+			}
+		})
+	}
+}
+
+func TestBoundedStackPop(t *testing.T) {
+	stk := NewBoundedStack[int](3, DropOldest)
+	for _, val := range []int{1, 2, 3, 4} {
+		stk.Push(val)
+	}
+
+	if got := stk.Peek(); got != 4 {
+		t.Fatalf("got %d; want 4", got)
+	}
+
+	for _, want := range []int{4, 3, 2} {
+		if got := stk.Pop(); got != want {
+			t.Fatalf("got %d; want %d", got, want)
+		}
+	}
+
+	if _, ok := stk.TryPop(); ok {
+		t.Fatal("expected empty stack to report false")
+	}
+
+	if _, ok := stk.TryPeek(); ok {
+		t.Fatal("expected empty stack to report false")
+	}
+
+	defer func() {
+		if err := recover(); err == nil {
+			t.Error("expected to panic but didn't")
+		}
+	}()
+	stk.Pop()
+}
+
+func TestBoundedStackBlock(t *testing.T) {
+	stk := NewBoundedStack[int](1, Block)
+	stk.Push(1)
+
+	done := make(chan struct{})
+	go func() {
+		stk.Push(2)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("push on a full blocking stack returned early")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	if got := stk.Pop(); got != 1 {
+		t.Fatalf("got %d; want 1", got)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("push was not released by pop")
+	}
+
+	if got := stk.ToSlice(); !slices.Equal(got, []int{2}) {
+		t.Fatalf("got %v; want [2]", got)
+	}
+}
+
+func TestBoundedStackShrink(t *testing.T) {
+	stk := NewBoundedStack[int](100, Reject)
+	for i := range 100 {
+		stk.Push(i)
+	}
+	for range 98 {
+		stk.Pop()
+	}
+
+	stk.Shrink()
+
+	if len(stk.buf) != 2 {
+		t.Fatalf("got buffer len %d; want 2", len(stk.buf))
+	}
+
+	if stk.Cap() != 100 {
+		t.Fatalf("got cap %d; want 100", stk.Cap())
+	}
+
+	if got := stk.ToSlice(); !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("got %v; want [0 1]", got)
+	}
+
+	for range 2 {
+		stk.Pop()
+	}
+	stk.Shrink()
+	stk.Push(7)
+
+	if got := stk.ToSlice(); !slices.Equal(got, []int{7}) {
+		t.Fatalf("got %v; want [7]", got)
+	}
+}
+
+func TestBoundedStackEdge(t *testing.T) {
+	var stk *BoundedStack[int]
+
+	defer func() {
+		if err := recover(); err != nil {
+			t.Error("expected not to panic but did")
+		}
+	}()
+
+	stk.Push(1)
+	stk.TryPop()
+	stk.TryPeek()
+	stk.Shrink()
+
+	if !stk.IsEmpty() || stk.Cap() != 0 {
+		t.Error("expected nil stack to be empty with zero cap")
+	}
+}
@@ -0,0 +1,309 @@
+package stack
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncStack is a Stack guarded by a mutex. Every method is safe to call from
+// multiple goroutines.
+type SyncStack[T any] struct {
+	mu    sync.Mutex
+	stack Stack[T]
+}
+
+func NewSyncStack[T any](base int) *SyncStack[T] {
+	return &SyncStack[T]{
+		stack: Stack[T]{
+			stack: make([]T, 0, base),
+		},
+	}
+}
+
+func (s *SyncStack[T]) Push(val T) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Push(val)
+}
+
+func (s *SyncStack[T]) Pop() T {
+	if s == nil {
+		var noop T
+		return noop
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Pop()
+}
+
+func (s *SyncStack[T]) TryPop() (T, bool) {
+	if s == nil {
+		var noop T
+		return noop, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPop()
+}
+
+func (s *SyncStack[T]) PopN(n int) ([]T, error) {
+	if s == nil {
+		return (*Stack[T])(nil).PopN(n)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.PopN(n)
+}
+
+func (s *SyncStack[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *SyncStack[T]) Peek() T {
+	if s == nil {
+		var noop T
+		return noop
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Peek()
+}
+
+func (s *SyncStack[T]) TryPeek() (T, bool) {
+	if s == nil {
+		var noop T
+		return noop, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.TryPeek()
+}
+
+func (s *SyncStack[T]) PeekN(n int) ([]T, error) {
+	if s == nil {
+		return (*Stack[T])(nil).PeekN(n)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.PeekN(n)
+}
+
+func (s *SyncStack[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Len()
+}
+
+func (s *SyncStack[T]) Cap() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.Cap()
+}
+
+func (s *SyncStack[T]) Shrink() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stack.Shrink()
+}
+
+func (s *SyncStack[T]) ToSlice() []T {
+	if s == nil {
+		return []T{}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stack.ToSlice()
+}
+
+// All iterates over a snapshot taken when iteration starts, so the lock is
+// not held while the loop body runs.
+func (s *SyncStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range s.ToSlice() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Backward iterates over a snapshot from the top of the stack to the bottom.
+func (s *SyncStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range slices.Backward(s.ToSlice()) {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed iterates over a snapshot with each element's distance from the
+// bottom of the stack.
+func (s *SyncStack[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, el := range s.ToSlice() {
+			if !yield(i, el) {
+				return
+			}
+		}
+	}
+}
+
+type atomicNode[T any] struct {
+	val  T
+	next *atomicNode[T]
+}
+
+// AtomicStack is a lock-free Treiber stack. Every push allocates a fresh
+// node and popped nodes are never reused, so a node address cannot come back
+// while another goroutine still holds it and the CAS in Pop is not subject
+// to the ABA problem.
+type AtomicStack[T any] struct {
+	top    atomic.Pointer[atomicNode[T]]
+	length atomic.Int64
+}
+
+func NewAtomicStack[T any]() *AtomicStack[T] {
+	return &AtomicStack[T]{}
+}
+
+func (s *AtomicStack[T]) Push(val T) {
+	if s == nil {
+		return
+	}
+
+	node := &atomicNode[T]{val: val}
+	for {
+		node.next = s.top.Load()
+		if s.top.CompareAndSwap(node.next, node) {
+			s.length.Add(1)
+			return
+		}
+	}
+}
+
+func (s *AtomicStack[T]) Pop() T {
+	val, ok := s.TryPop()
+	if !ok && s != nil {
+		panic(ErrEmptyStack)
+	}
+	return val
+}
+
+func (s *AtomicStack[T]) TryPop() (T, bool) {
+	var noop T
+	if s == nil {
+		return noop, false
+	}
+
+	for {
+		top := s.top.Load()
+		if top == nil {
+			return noop, false
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.length.Add(-1)
+			return top.val, true
+		}
+	}
+}
+
+func (s *AtomicStack[T]) IsEmpty() bool {
+	return s == nil || s.top.Load() == nil
+}
+
+func (s *AtomicStack[T]) Peek() T {
+	val, ok := s.TryPeek()
+	if !ok && s != nil {
+		panic(ErrEmptyStack)
+	}
+	return val
+}
+
+func (s *AtomicStack[T]) TryPeek() (T, bool) {
+	if s == nil {
+		var noop T
+		return noop, false
+	}
+
+	top := s.top.Load()
+	if top == nil {
+		var noop T
+		return noop, false
+	}
+	return top.val, true
+}
+
+// Len is exact when the stack is not being modified and approximate while
+// pushes and pops are in flight.
+func (s *AtomicStack[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return int(max(s.length.Load(), 0))
+}
+
+// ToSlice returns a snapshot of the stack from the bottom to the top.
+func (s *AtomicStack[T]) ToSlice() []T {
+	if s == nil {
+		return []T{}
+	}
+
+	ret := make([]T, 0, s.Len())
+	for node := s.top.Load(); node != nil; node = node.next {
+		ret = append(ret, node.val)
+	}
+	slices.Reverse(ret)
+	return ret
+}
+
+// All iterates over a snapshot from the bottom of the stack to the top.
+func (s *AtomicStack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range s.ToSlice() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Backward walks the stack from the top down without taking a snapshot.
+func (s *AtomicStack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s == nil {
+			return
+		}
+		for node := s.top.Load(); node != nil; node = node.next {
+			if !yield(node.val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package stack
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+type testStack interface {
+	Push(int)
+	Pop() int
+	TryPop() (int, bool)
+	Peek() int
+	IsEmpty() bool
+	Len() int
+	ToSlice() []int
+}
+
+var testStacks = []struct {
+	name string
+	new  func() testStack
+}{
+	{
+		name: "Stack",
+		new:  func() testStack { return NewStack[int](0) },
+	}, {
+		name: "SyncStack",
+		new:  func() testStack { return NewSyncStack[int](0) },
+	}, {
+		name: "AtomicStack",
+		new:  func() testStack { return NewAtomicStack[int]() },
+	},
+}
+
+// TestConcurrentStacksScenarios runs the Stack scenarios from stack_test.go
+// against every implementation.
+func TestConcurrentStacksScenarios(t *testing.T) {
+	fill := func(impl func() testStack, init []int) testStack {
+		stk := impl()
+		for _, val := range init {
+			stk.Push(val)
+		}
+		return stk
+	}
+	check := func(t *testing.T, stk testStack, want []int) {
+		t.Helper()
+
+		if got := stk.ToSlice(); !slices.Equal(got, want) {
+			t.Fatalf("got %v; want %v", got, want)
+		}
+		if stk.Len() != len(want) {
+			t.Fatalf("got len %d; want %d", stk.Len(), len(want))
+		}
+		if len(want) > 0 && stk.Peek() != want[len(want)-1] {
+			t.Fatalf("got top %d; want %d", stk.Peek(), want[len(want)-1])
+		}
+	}
+
+	for _, impl := range testStacks {
+		for _, tt := range stackPushCases {
+			t.Run(impl.name+"/push/"+tt.name, func(t *testing.T) {
+				stk := fill(impl.new, tt.init)
+				for _, val := range tt.insertVals {
+					stk.Push(val)
+				}
+				check(t, stk, tt.wantVals)
+			})
+		}
+
+		for _, tt := range stackPopCases {
+			t.Run(impl.name+"/pop/"+tt.name, func(t *testing.T) {
+				if tt.shouldPanic {
+					defer func() {
+						if err := recover(); err != ErrEmptyStack {
+							t.Errorf("got panic %v; want %v", err, ErrEmptyStack)
+						}
+					}()
+				}
+
+				stk := fill(impl.new, tt.init)
+				for range tt.amount {
+					stk.Pop()
+				}
+				check(t, stk, tt.wantVals)
+			})
+		}
+
+		for _, tt := range stackIsEmptyCases {
+			t.Run(impl.name+"/is empty/"+tt.name, func(t *testing.T) {
+				stk := fill(impl.new, tt.init)
+				for _, val := range tt.insert {
+					stk.Push(val)
+				}
+				for range tt.remove {
+					stk.Pop()
+				}
+
+				if stk.IsEmpty() != tt.want {
+					t.Fatalf("got %t; want %t", stk.IsEmpty(), tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestConcurrentStacksEdge(t *testing.T) {
+	defer func() {
+		if err := recover(); err != nil {
+			t.Error("expected not to panic but did")
+		}
+	}()
+
+	var syncStk *SyncStack[int]
+	syncStk.Push(1)
+	syncStk.Pop()
+	if !syncStk.IsEmpty() {
+		t.Error("expected nil SyncStack to be empty")
+	}
+
+	var atomicStk *AtomicStack[int]
+	atomicStk.Push(1)
+	atomicStk.Pop()
+	if !atomicStk.IsEmpty() {
+		t.Error("expected nil AtomicStack to be empty")
+	}
+}
+
+func TestSyncStackPopNNegative(t *testing.T) {
+	stk := NewSyncStack[int](0)
+	stk.Push(1)
+
+	if _, err := stk.PeekN(-1); !errors.Is(err, ErrNegativeCount) {
+		t.Errorf("PeekN: got error %v; want %v", err, ErrNegativeCount)
+	}
+	if _, err := stk.PopN(-1); !errors.Is(err, ErrNegativeCount) {
+		t.Errorf("PopN: got error %v; want %v", err, ErrNegativeCount)
+	}
+	if stk.Len() != 1 {
+		t.Errorf("got len %d; want 1", stk.Len())
+	}
+}
+
+func TestConcurrentStacksStress(t *testing.T) {
+	const (
+		workers = 8
+		perWork = 2000
+	)
+
+	for _, impl := range testStacks[1:] {
+		t.Run(impl.name, func(t *testing.T) {
+			stk := impl.new()
+
+			var wg sync.WaitGroup
+			for w := range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := range perWork {
+						stk.Push(w*perWork + i)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if stk.Len() != workers*perWork {
+				t.Fatalf("got len %d; want %d", stk.Len(), workers*perWork)
+			}
+
+			popped := make([][]int, workers)
+			for w := range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for {
+						val, ok := stk.TryPop()
+						if !ok {
+							return
+						}
+						popped[w] = append(popped[w], val)
+					}
+				}()
+			}
+			wg.Wait()
+
+			got := slices.Concat(popped...)
+			slices.Sort(got)
+			for i, val := range got {
+				if val != i {
+					t.Fatalf("got %d at index %d; every pushed value should be popped exactly once", val, i)
+				}
+			}
+			if len(got) != workers*perWork {
+				t.Fatalf("popped %d values; want %d", len(got), workers*perWork)
+			}
+		})
+	}
+}
+
+func BenchmarkConcurrentStacks(b *testing.B) {
+	for _, impl := range testStacks[1:] {
+		b.Run(impl.name, func(b *testing.B) {
+			stk := impl.new()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					stk.Push(1)
+					stk.TryPop()
+				}
+			})
+		})
+	}
+}
@@ -1,5 +1,15 @@
 package stack
 
+import (
+	"errors"
+	"iter"
+)
+
+var (
+	ErrEmptyStack    = errors.New("stack: not enough elements")
+	ErrNegativeCount = errors.New("stack: negative count")
+)
+
 type Stack[T any] struct {
 	stack []T
 }
@@ -17,29 +27,88 @@ func (s *Stack[T]) Push(val T) {
 	s.stack = append(s.stack, val)
 }
 
+// Pop removes and returns the top element. It panics with ErrEmptyStack if
+// the stack is empty.
 func (s *Stack[T]) Pop() T {
 	if s == nil {
 		var noop T
 		return noop
 	}
+	if s.IsEmpty() {
+		panic(ErrEmptyStack)
+	}
 
 	val := s.stack[len(s.stack)-1]
 	s.stack = s.stack[:len(s.stack)-1]
 	return val
 }
 
+// TryPop removes and returns the top element, reporting false instead of
+// panicking when the stack is empty.
+func (s *Stack[T]) TryPop() (T, bool) {
+	if s.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return s.Pop(), true
+}
+
+// PopN removes the top n elements and returns them in pop order. The stack is
+// left untouched and ErrEmptyStack is returned if it holds fewer than n, or
+// ErrNegativeCount if n is negative.
+func (s *Stack[T]) PopN(n int) ([]T, error) {
+	vals, err := s.PeekN(n)
+	if err != nil || len(vals) == 0 {
+		return vals, err
+	}
+	s.stack = s.stack[:len(s.stack)-len(vals)]
+	return vals, nil
+}
+
 func (s *Stack[T]) IsEmpty() bool {
 	return s.Len() == 0
 }
 
+// Peek returns the top element. It panics with ErrEmptyStack if the stack
+// is empty.
 func (s *Stack[T]) Peek() T {
 	if s == nil {
 		var noop T
 		return noop
 	}
+	if s.IsEmpty() {
+		panic(ErrEmptyStack)
+	}
 	return s.stack[len(s.stack)-1]
 }
 
+// TryPeek returns the top element, reporting false when the stack is empty.
+func (s *Stack[T]) TryPeek() (T, bool) {
+	if s.IsEmpty() {
+		var noop T
+		return noop, false
+	}
+	return s.Peek(), true
+}
+
+// PeekN returns the top n elements in pop order without removing them. It
+// returns ErrNegativeCount if n is negative and ErrEmptyStack if the stack
+// holds fewer than n.
+func (s *Stack[T]) PeekN(n int) ([]T, error) {
+	if n < 0 {
+		return nil, ErrNegativeCount
+	}
+	if n > s.Len() {
+		return nil, ErrEmptyStack
+	}
+
+	ret := make([]T, 0, n)
+	for i := range n {
+		ret = append(ret, s.stack[len(s.stack)-1-i])
+	}
+	return ret, nil
+}
+
 func (s *Stack[T]) Len() int {
 	if s == nil {
 		return 0
@@ -54,6 +123,17 @@ func (s *Stack[T]) Cap() int {
 	return cap(s.stack)
 }
 
+// Shrink releases backing capacity not used by the current elements.
+func (s *Stack[T]) Shrink() {
+	if s == nil {
+		return
+	}
+
+	shrunk := make([]T, len(s.stack))
+	copy(shrunk, s.stack)
+	s.stack = shrunk
+}
+
 func (s *Stack[T]) ToSlice() []T {
 	ret := make([]T, 0, s.Len())
 	for _, el := range s.stack {
@@ -61,3 +141,38 @@ func (s *Stack[T]) ToSlice() []T {
 	}
 	return ret
 }
+
+// All yields the elements from the bottom of the stack to the top, the same
+// order as ToSlice.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, el := range s.Indexed() {
+			if !yield(el) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields the elements from the top of the stack to the bottom, the
+// order in which Pop would return them.
+func (s *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.Len() - 1; i >= 0; i-- {
+			if !yield(s.stack[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Indexed yields each element with its distance from the bottom of the stack.
+func (s *Stack[T]) Indexed() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range s.Len() {
+			if !yield(i, s.stack[i]) {
+				return
+			}
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package stack
 
-import "testing"
+import (
+	"errors"
+	"slices"
+	"testing"
+)
 
 func TestNewStack(t *testing.T) {
 	baseCap := 10
@@ -34,27 +38,29 @@ func TestNewStackEdge(t *testing.T) {
 	}
 }
 
-func TestStackPush(t *testing.T) {
-	cases := []struct {
-		name       string
-		init       []int
-		insertVals []int
-		wantVals   []int
-	}{
-		{
-			name:       "insert single element to empty list",
-			init:       []int{},
-			insertVals: []int{1},
-			wantVals:   []int{1},
-		}, {
-			name:       "insert single element to non-empty list",
-			init:       []int{1, 2, 3},
-			insertVals: []int{4},
-			wantVals:   []int{1, 2, 3, 4},
-		},
-	}
+// The scenario tables are package-level so TestConcurrentStacksScenarios can
+// run them against every stack implementation.
+var stackPushCases = []struct {
+	name       string
+	init       []int
+	insertVals []int
+	wantVals   []int
+}{
+	{
+		name:       "insert single element to empty list",
+		init:       []int{},
+		insertVals: []int{1},
+		wantVals:   []int{1},
+	}, {
+		name:       "insert single element to non-empty list",
+		init:       []int{1, 2, 3},
+		insertVals: []int{4},
+		wantVals:   []int{1, 2, 3, 4},
+	},
+}
 
-	for _, tt := range cases {
+func TestStackPush(t *testing.T) {
+	for _, tt := range stackPushCases {
 		t.Run(tt.name, func(t *testing.T) {
 			stk := fromSlice(t, tt.init)
 
@@ -92,51 +98,51 @@ func TestStackPushEdge(t *testing.T) {
 	stk.Push(1)
 }
 
-func TestStackPop(t *testing.T) {
-	cases := []struct {
-		name        string
-		init        []int
-		amount      int
-		wantVals    []int
-		shouldPanic bool
-	}{
-		{
-			name:        "pop single element from empty stack",
-			init:        []int{},
-			amount:      1,
-			wantVals:    []int{},
-			shouldPanic: true,
-		}, {
-			name:     "pop single element from non-empty stack",
-			init:     []int{1, 2, 3},
-			amount:   1,
-			wantVals: []int{1, 2},
-		}, {
-			name:        "pop multi element from empty stack",
-			init:        []int{},
-			amount:      4,
-			wantVals:    []int{},
-			shouldPanic: true,
-		}, {
-			name:        "pop multi element from non-empty stack",
-			init:        []int{1, 2, 3},
-			amount:      4,
-			wantVals:    []int{},
-			shouldPanic: true,
-		}, {
-			name:     "pop multi element from non-empty stack with leftovers",
-			init:     []int{1, 2, 3, 4, 5},
-			amount:   4,
-			wantVals: []int{1},
-		},
-	}
+var stackPopCases = []struct {
+	name        string
+	init        []int
+	amount      int
+	wantVals    []int
+	shouldPanic bool
+}{
+	{
+		name:        "pop single element from empty stack",
+		init:        []int{},
+		amount:      1,
+		wantVals:    []int{},
+		shouldPanic: true,
+	}, {
+		name:     "pop single element from non-empty stack",
+		init:     []int{1, 2, 3},
+		amount:   1,
+		wantVals: []int{1, 2},
+	}, {
+		name:        "pop multi element from empty stack",
+		init:        []int{},
+		amount:      4,
+		wantVals:    []int{},
+		shouldPanic: true,
+	}, {
+		name:        "pop multi element from non-empty stack",
+		init:        []int{1, 2, 3},
+		amount:      4,
+		wantVals:    []int{},
+		shouldPanic: true,
+	}, {
+		name:     "pop multi element from non-empty stack with leftovers",
+		init:     []int{1, 2, 3, 4, 5},
+		amount:   4,
+		wantVals: []int{1},
+	},
+}
 
-	for _, tt := range cases {
+func TestStackPop(t *testing.T) {
+	for _, tt := range stackPopCases {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.shouldPanic {
 				defer func() {
-					if err := recover(); err == nil {
-						t.Error("expected to panic but didn't")
+					if err := recover(); err != ErrEmptyStack {
+						t.Errorf("got panic %v; want %v", err, ErrEmptyStack)
 					}
 				}()
 			}
@@ -177,42 +183,61 @@ func TestStackPopEdge(t *testing.T) {
 	stk.Pop()
 }
 
-func TestStackIsEmpty(t *testing.T) {
-	cases := []struct {
-		name   string
-		init   []int
-		insert []int
-		remove int
-		want   bool
-	}{
-		{
-			name:   "empty stack",
-			init:   []int{},
-			insert: []int{},
-			remove: 0,
-			want:   true,
-		}, {
-			name:   "non-empty stack",
-			init:   []int{1, 2, 3},
-			insert: []int{},
-			remove: 0,
-			want:   false,
-		}, {
-			name:   "non-empty stack after inserts",
-			init:   []int{},
-			insert: []int{1, 2, 3},
-			remove: 0,
-			want:   false,
-		}, {
-			name:   "empty stack after inserts",
-			init:   []int{},
-			insert: []int{1, 2, 3},
-			remove: 3,
-			want:   true,
-		},
+func TestStackEmptyPanics(t *testing.T) {
+	ops := map[string]func(*Stack[int]) int{
+		"Pop":  (*Stack[int]).Pop,
+		"Peek": (*Stack[int]).Peek,
 	}
 
-	for _, tt := range cases {
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if err := recover(); err != ErrEmptyStack {
+					t.Errorf("got panic %v; want %v", err, ErrEmptyStack)
+				}
+			}()
+
+			op(NewStack[int](0))
+		})
+	}
+}
+
+var stackIsEmptyCases = []struct {
+	name   string
+	init   []int
+	insert []int
+	remove int
+	want   bool
+}{
+	{
+		name:   "empty stack",
+		init:   []int{},
+		insert: []int{},
+		remove: 0,
+		want:   true,
+	}, {
+		name:   "non-empty stack",
+		init:   []int{1, 2, 3},
+		insert: []int{},
+		remove: 0,
+		want:   false,
+	}, {
+		name:   "non-empty stack after inserts",
+		init:   []int{},
+		insert: []int{1, 2, 3},
+		remove: 0,
+		want:   false,
+	}, {
+		name:   "empty stack after inserts",
+		init:   []int{},
+		insert: []int{1, 2, 3},
+		remove: 3,
+		want:   true,
+	},
+}
+
+func TestStackIsEmpty(t *testing.T) {
+	for _, tt := range stackIsEmptyCases {
 		t.Run(tt.name, func(t *testing.T) {
 			stk := fromSlice(t, tt.init)
 
@@ -244,6 +269,222 @@ func TestStackIsEmptyEdge(t *testing.T) {
 	}
 }
 
+func TestStackTryPop(t *testing.T) {
+	stk := fromSlice(t, []int{1, 2})
+
+	for _, want := range []int{2, 1} {
+		got, ok := stk.TryPop()
+		if !ok || got != want {
+			t.Fatalf("got (%d, %t); want (%d, true)", got, ok, want)
+		}
+	}
+
+	if got, ok := stk.TryPop(); ok || got != 0 {
+		t.Fatalf("got (%d, %t); want (0, false)", got, ok)
+	}
+
+	var nilStk *Stack[int]
+	if _, ok := nilStk.TryPop(); ok {
+		t.Fatal("expected nil stack to report false")
+	}
+}
+
+func TestStackTryPeek(t *testing.T) {
+	stk := fromSlice(t, []int{1, 2})
+
+	if got, ok := stk.TryPeek(); !ok || got != 2 {
+		t.Fatalf("got (%d, %t); want (2, true)", got, ok)
+	}
+
+	if stk.Len() != 2 {
+		t.Fatalf("got len %d; want 2", stk.Len())
+	}
+
+	empty := fromSlice(t, []int{})
+	if _, ok := empty.TryPeek(); ok {
+		t.Fatal("expected empty stack to report false")
+	}
+
+	var nilStk *Stack[int]
+	if _, ok := nilStk.TryPeek(); ok {
+		t.Fatal("expected nil stack to report false")
+	}
+}
+
+func TestStackPopN(t *testing.T) {
+	cases := []struct {
+		name     string
+		init     []int
+		amount   int
+		want     []int
+		wantVals []int
+		wantErr  error
+	}{
+		{
+			name:     "pop none",
+			init:     []int{1, 2, 3},
+			amount:   0,
+			want:     []int{},
+			wantVals: []int{1, 2, 3},
+		}, {
+			name:     "pop some",
+			init:     []int{1, 2, 3},
+			amount:   2,
+			want:     []int{3, 2},
+			wantVals: []int{1},
+		}, {
+			name:     "pop all",
+			init:     []int{1, 2, 3},
+			amount:   3,
+			want:     []int{3, 2, 1},
+			wantVals: []int{},
+		}, {
+			name:     "pop too many",
+			init:     []int{1, 2, 3},
+			amount:   4,
+			wantVals: []int{1, 2, 3},
+			wantErr:  ErrEmptyStack,
+		}, {
+			name:     "pop from empty",
+			init:     []int{},
+			amount:   1,
+			wantVals: []int{},
+			wantErr:  ErrEmptyStack,
+		}, {
+			name:     "pop negative",
+			init:     []int{1, 2, 3},
+			amount:   -1,
+			wantVals: []int{1, 2, 3},
+			wantErr:  ErrNegativeCount,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			stk := fromSlice(t, tt.init)
+
+			peeked, err := stk.PeekN(tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PeekN: got error %v; want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(peeked, tt.want) {
+				t.Fatalf("PeekN: got %v; want %v", peeked, tt.want)
+			}
+			if stk.Len() != len(tt.init) {
+				t.Fatalf("PeekN: got len %d; want %d", stk.Len(), len(tt.init))
+			}
+
+			got, err := stk.PopN(tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PopN: got error %v; want %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("PopN: got %v; want %v", got, tt.want)
+			}
+			if left := stk.ToSlice(); !slices.Equal(left, tt.wantVals) {
+				t.Fatalf("got stack %v; want %v", left, tt.wantVals)
+			}
+		})
+	}
+}
+
+func TestStackPopNEdge(t *testing.T) {
+	var stk *Stack[int]
+
+	if _, err := stk.PopN(1); !errors.Is(err, ErrEmptyStack) {
+		t.Errorf("got error %v; want %v", err, ErrEmptyStack)
+	}
+
+	if got, err := stk.PopN(0); err != nil || len(got) != 0 {
+		t.Errorf("got (%v, %v); want empty and nil", got, err)
+	}
+
+	if _, err := stk.PeekN(-1); !errors.Is(err, ErrNegativeCount) {
+		t.Errorf("got error %v; want %v", err, ErrNegativeCount)
+	}
+}
+
+func TestStackShrink(t *testing.T) {
+	stk := NewStack[int](100)
+	for i := range 10 {
+		stk.Push(i)
+	}
+
+	stk.Shrink()
+
+	if stk.Cap() != 10 {
+		t.Fatalf("got cap %d; want 10", stk.Cap())
+	}
+
+	if got, want := stk.ToSlice(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Fatalf("got %v; want %v", got, want)
+	}
+}
+
+func TestStackIterators(t *testing.T) {
+	cases := []struct {
+		name string
+		init []int
+	}{
+		{
+			name: "empty stack",
+			init: []int{},
+		}, {
+			name: "non-empty stack",
+			init: []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			stk := fromSlice(t, tt.init)
+
+			if got := slices.Collect(stk.All()); !slices.Equal(got, tt.init) {
+				t.Errorf("All: got %v; want %v", got, tt.init)
+			}
+
+			want := slices.Clone(tt.init)
+			slices.Reverse(want)
+			if got := slices.Collect(stk.Backward()); !slices.Equal(got, want) {
+				t.Errorf("Backward: got %v; want %v", got, want)
+			}
+
+			for i, el := range stk.Indexed() {
+				if el != tt.init[i] {
+					t.Errorf("Indexed: got element %d at index %d; want %d", el, i, tt.init[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStackIteratorsBreak(t *testing.T) {
+	stk := fromSlice(t, []int{1, 2, 3, 4})
+
+	var got []int
+	for el := range stk.Backward() {
+		if el == 2 {
+			break
+		}
+		got = append(got, el)
+	}
+
+	if want := []int{4, 3}; !slices.Equal(got, want) {
+		t.Errorf("got %v; want %v", got, want)
+	}
+}
+
+func TestStackIteratorsEdge(t *testing.T) {
+	var stk *Stack[int]
+
+	if got := slices.Collect(stk.All()); len(got) != 0 {
+		t.Errorf("All: got %v; want empty", got)
+	}
+	if got := slices.Collect(stk.Backward()); len(got) != 0 {
+		t.Errorf("Backward: got %v; want empty", got)
+	}
+}
+
 /*
 	func (s *Stack[T]) IsEmpty() bool {
 		return s.Len() == 0